@@ -0,0 +1,180 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/thanos-io/thanos/pkg/store/storepb"
+)
+
+// GRPCClient adapts a storepb.RulesClient into the buffer-everything
+// UnaryClient interface consumed by the proxy and the HTTP handler, the
+// same way pkg/store's grpc client wraps a streaming StoreClient.
+type GRPCClient struct {
+	storepb.RulesClient
+	address string
+}
+
+// NewGRPCClient returns a rules Client backed by a gRPC Rules service at
+// address.
+func NewGRPCClient(rc storepb.RulesClient, address string) *GRPCClient {
+	return &GRPCClient{RulesClient: rc, address: address}
+}
+
+func (c *GRPCClient) String() string { return c.address }
+func (c *GRPCClient) Addr() string   { return c.address }
+
+// Rules implements UnaryClient by draining the Rules stream into a single
+// RuleGroups/warnings pair.
+func (c *GRPCClient) Rules(ctx context.Context, req *storepb.RulesRequest) (*storepb.RuleGroups, []string, error) {
+	stream, err := c.RulesClient.Rules(ctx, req)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "opening rules stream")
+	}
+
+	result := &storepb.RuleGroups{}
+	var warnings []string
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, nil, errors.Wrap(err, "receiving rules response")
+		}
+		if w := resp.GetWarning(); w != "" {
+			warnings = append(warnings, w)
+			continue
+		}
+		if g := resp.GetGroup(); g != nil {
+			result.Groups = append(result.Groups, g)
+		}
+	}
+	return result, warnings, nil
+}
+
+// API serves Thanos-Query's federated /api/v1/rules endpoint, backed by a
+// Proxy that fans out to the configured rule-serving backends.
+type API struct {
+	logger log.Logger
+	proxy  storepb.RulesServer
+}
+
+// NewAPI returns a new rules HTTP API serving /api/v1/rules.
+func NewAPI(logger log.Logger, proxy storepb.RulesServer) *API {
+	return &API{logger: logger, proxy: proxy}
+}
+
+// Rules renders the merged rule groups in the same JSON shape Prometheus's
+// own /api/v1/rules endpoint uses, honoring the same type/rule_name[]/
+// rule_group[]/file[] query parameters Prometheus supports, plus Thanos's
+// partial_response_strategy override.
+func (a *API) Rules(w http.ResponseWriter, r *http.Request) {
+	req, err := parseRulesRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	srv := newRulesServer(r.Context())
+	if err := a.proxy.Rules(req, srv); err != nil {
+		level.Error(a.logger).Log("err", err, "msg", "rules proxy failed")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeRulesResponse(w, srv.groups, srv.warnings)
+}
+
+// parseRulesRequest builds a storepb.RulesRequest from the query parameters
+// of an /api/v1/rules request.
+func parseRulesRequest(r *http.Request) (*storepb.RulesRequest, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, errors.Wrap(err, "parsing form")
+	}
+
+	req := &storepb.RulesRequest{
+		Type:                    storepb.RulesRequest_ALL,
+		PartialResponseStrategy: storepb.PartialResponseStrategy_WARN,
+		RuleName:                r.Form["rule_name[]"],
+		RuleGroup:               r.Form["rule_group[]"],
+		File:                    r.Form["file[]"],
+	}
+
+	switch typ := strings.ToLower(r.Form.Get("type")); typ {
+	case "alert":
+		req.Type = storepb.RulesRequest_ALERT
+	case "record":
+		req.Type = storepb.RulesRequest_RECORD
+	case "", "all":
+	default:
+		return nil, errors.Errorf("unknown rule type %q", typ)
+	}
+
+	if s := r.Form.Get("partial_response_strategy"); s != "" {
+		strategy, ok := storepb.PartialResponseStrategy_value[strings.ToUpper(s)]
+		if !ok {
+			return nil, errors.Errorf("unknown partial_response_strategy %q", s)
+		}
+		req.PartialResponseStrategy = storepb.PartialResponseStrategy(strategy)
+	}
+
+	return req, nil
+}
+
+// rulesServer is an in-process storepb.Rules_RulesServer that buffers the
+// streamed responses instead of writing them to the wire, so the HTTP
+// handler can reuse the same Proxy that serves gRPC callers.
+type rulesServer struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	groups   []*storepb.RuleGroup
+	warnings []string
+}
+
+func newRulesServer(ctx context.Context) *rulesServer {
+	return &rulesServer{ctx: ctx}
+}
+
+func (s *rulesServer) Context() context.Context { return s.ctx }
+
+func (s *rulesServer) Send(resp *storepb.RulesResponse) error {
+	if g := resp.GetGroup(); g != nil {
+		s.groups = append(s.groups, g)
+		return nil
+	}
+	s.warnings = append(s.warnings, resp.GetWarning())
+	return nil
+}
+
+// rulesDiscovery mirrors Prometheus's own /api/v1/rules response envelope.
+type rulesDiscovery struct {
+	RuleGroups []*storepb.RuleGroup `json:"groups"`
+}
+
+type apiResponse struct {
+	Status   string          `json:"status"`
+	Data     *rulesDiscovery `json:"data,omitempty"`
+	Warnings []string        `json:"warnings,omitempty"`
+}
+
+func writeRulesResponse(w http.ResponseWriter, groups []*storepb.RuleGroup, warnings []string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&apiResponse{
+		Status:   "success",
+		Data:     &rulesDiscovery{RuleGroups: groups},
+		Warnings: warnings,
+	})
+}
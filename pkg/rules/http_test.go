@@ -0,0 +1,87 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package rules
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thanos-io/thanos/pkg/store/storepb"
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+func TestParseRulesRequest(t *testing.T) {
+	for _, tcase := range []struct {
+		name string
+		url  string
+
+		expected    *storepb.RulesRequest
+		expectedErr bool
+	}{
+		{
+			name: "no query params",
+			url:  "/api/v1/rules",
+			expected: &storepb.RulesRequest{
+				Type:                    storepb.RulesRequest_ALL,
+				PartialResponseStrategy: storepb.PartialResponseStrategy_WARN,
+			},
+		},
+		{
+			name: "type=alert",
+			url:  "/api/v1/rules?type=alert",
+			expected: &storepb.RulesRequest{
+				Type:                    storepb.RulesRequest_ALERT,
+				PartialResponseStrategy: storepb.PartialResponseStrategy_WARN,
+			},
+		},
+		{
+			name: "type=record",
+			url:  "/api/v1/rules?type=record",
+			expected: &storepb.RulesRequest{
+				Type:                    storepb.RulesRequest_RECORD,
+				PartialResponseStrategy: storepb.PartialResponseStrategy_WARN,
+			},
+		},
+		{
+			name:        "unknown type",
+			url:         "/api/v1/rules?type=bogus",
+			expectedErr: true,
+		},
+		{
+			name: "rule_name/rule_group/file filters",
+			url:  "/api/v1/rules?rule_name[]=HighErrorRate&rule_group[]=example&file[]=alerts.yml",
+			expected: &storepb.RulesRequest{
+				Type:                    storepb.RulesRequest_ALL,
+				PartialResponseStrategy: storepb.PartialResponseStrategy_WARN,
+				RuleName:                []string{"HighErrorRate"},
+				RuleGroup:               []string{"example"},
+				File:                    []string{"alerts.yml"},
+			},
+		},
+		{
+			name: "partial_response_strategy=abort",
+			url:  "/api/v1/rules?partial_response_strategy=abort",
+			expected: &storepb.RulesRequest{
+				Type:                    storepb.RulesRequest_ALL,
+				PartialResponseStrategy: storepb.PartialResponseStrategy_ABORT,
+			},
+		},
+		{
+			name:        "unknown partial_response_strategy",
+			url:         "/api/v1/rules?partial_response_strategy=bogus",
+			expectedErr: true,
+		},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tcase.url, nil)
+			req, err := parseRulesRequest(r)
+			if tcase.expectedErr {
+				testutil.NotOk(t, err)
+				return
+			}
+			testutil.Ok(t, err)
+			testutil.Equals(t, tcase.expected.String(), req.String())
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package rules
+
+import (
+	"sync"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/thanos-io/thanos/pkg/store/storepb"
+)
+
+// Proxy implements storepb.RulesServer that fans out a Rules request to a
+// dynamic set of Clients (sidecars, rulers, ...), merges the results and
+// streams the merged rule groups back to the caller. It is the pkg/rules
+// analogue of the store proxy used for Series/LabelNames/LabelValues.
+type Proxy struct {
+	logger  log.Logger
+	clients func() []Client
+}
+
+// NewProxy returns a new rules proxy that will fan out requests to the
+// Clients returned by the given clients function on every call, so that the
+// set of downstreams can change at runtime (e.g. via service discovery).
+func NewProxy(logger log.Logger, clients func() []Client) *Proxy {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	return &Proxy{
+		logger:  logger,
+		clients: clients,
+	}
+}
+
+// Rules implements storepb.RulesServer.
+func (s *Proxy) Rules(req *storepb.RulesRequest, srv storepb.Rules_RulesServer) error {
+	var (
+		g        errgroup.Group
+		mtx      sync.Mutex
+		all      [][]*storepb.RuleGroup
+		warnings []string
+	)
+
+	for _, c := range s.clients() {
+		c := c
+		g.Go(func() error {
+			rg, w, err := c.Rules(srv.Context(), req)
+			if err != nil {
+				err = errors.Wrapf(err, "fetching rules from %s", c)
+				if req.PartialResponseStrategy == storepb.PartialResponseStrategy_ABORT {
+					return err
+				}
+				level.Warn(s.logger).Log("err", err, "msg", "partial rules response")
+				mtx.Lock()
+				warnings = append(warnings, err.Error())
+				mtx.Unlock()
+				return nil
+			}
+
+			mtx.Lock()
+			all = append(all, rg.Groups)
+			warnings = append(warnings, w...)
+			mtx.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	for _, w := range warnings {
+		if err := srv.Send(storepb.NewWarningRulesResponse(errors.New(w))); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range mergeGroups(all...) {
+		group := group
+		if !matchesRequest(req, group) {
+			continue
+		}
+		group.Rules = filterRules(req, group.Rules)
+		if err := srv.Send(storepb.NewRuleGroupRulesResponse(group)); err != nil {
+			return errors.Wrap(err, "sending rules response")
+		}
+	}
+	return nil
+}
+
+var _ storepb.RulesServer = &Proxy{}
@@ -0,0 +1,185 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+// Package rules implements a federated rules discovery subsystem, analogous
+// to pkg/store's series federation: it fans Rules requests out to a set of
+// downstream Rules APIs (sidecars, rulers), deduplicates the results and
+// re-serves them as a single storepb.Rules server.
+package rules
+
+import (
+	"context"
+
+	"github.com/thanos-io/thanos/pkg/store/storepb"
+)
+
+// UnaryClient is the interface between the rules proxy and a single
+// downstream Rules API. It is a synchronous, buffer-everything counterpart
+// of storepb.RulesClient, mirroring how pkg/store's StoreClient is used by
+// the store proxy.
+type UnaryClient interface {
+	Rules(ctx context.Context, req *storepb.RulesRequest) (*storepb.RuleGroups, []string, error)
+}
+
+// Client holds meta information about a Rules API backend needed by the
+// proxy to decide whether, and with which strategy, it should be queried.
+type Client interface {
+	UnaryClient
+
+	// String returns the string representation of the Rules client. It
+	// should describe itself in as much detail as possible, so that if
+	// it is part of a fan-out request, the user can understand which
+	// backend it is talking to.
+	String() string
+
+	// Addr returns address of the Rules client.
+	Addr() string
+}
+
+// dedupKey identifies a rule group independent of which downstream produced
+// it, by the same (file, name) pair Prometheus itself uses.
+type dedupKey struct {
+	file string
+	name string
+}
+
+// mergeGroups merges a set of RuleGroups slices (typically one per
+// downstream Client) into one, deduplicating groups by (file, name). When
+// the same group is produced by more than one downstream, their rules are
+// merged rule-by-rule and, for alerting rules, their active alerts are
+// merged instance-by-instance by label set.
+func mergeGroups(all ...[]*storepb.RuleGroup) []*storepb.RuleGroup {
+	order := make([]dedupKey, 0, len(all))
+	merged := make(map[dedupKey]*storepb.RuleGroup, len(all))
+
+	for _, groups := range all {
+		for _, g := range groups {
+			key := dedupKey{file: g.File, name: g.Name}
+			existing, ok := merged[key]
+			if !ok {
+				merged[key] = g
+				order = append(order, key)
+				continue
+			}
+			existing.Rules = mergeRules(existing.Rules, g.Rules)
+		}
+	}
+
+	out := make([]*storepb.RuleGroup, 0, len(order))
+	for _, key := range order {
+		out = append(out, merged[key])
+	}
+	return out
+}
+
+// mergeRules merges two rule slices belonging to groups that share a
+// (file, name) key, merging alert instances of matching alerting rules by
+// label set and otherwise appending rules unseen so far.
+func mergeRules(base, other []*storepb.Rule) []*storepb.Rule {
+	byAlertName := make(map[string]*storepb.Alert, len(base))
+	seenRecording := make(map[string]struct{}, len(base))
+	for _, r := range base {
+		if a := r.GetAlert(); a != nil {
+			byAlertName[a.Name] = a
+		}
+		if rr := r.GetRecording(); rr != nil {
+			seenRecording[rr.Name+storepb.PromLabelsToLabels(&rr.Labels).String()] = struct{}{}
+		}
+	}
+
+	for _, r := range other {
+		a := r.GetAlert()
+		if a == nil {
+			if rr := r.GetRecording(); rr != nil {
+				key := rr.Name + storepb.PromLabelsToLabels(&rr.Labels).String()
+				if _, ok := seenRecording[key]; ok {
+					continue
+				}
+				seenRecording[key] = struct{}{}
+			}
+			base = append(base, r)
+			continue
+		}
+		existing, ok := byAlertName[a.Name]
+		if !ok {
+			byAlertName[a.Name] = a
+			base = append(base, r)
+			continue
+		}
+		existing.Alerts = mergeAlertInstances(existing.Alerts, a.Alerts)
+	}
+	return base
+}
+
+// mergeAlertInstances merges two alert instance slices, deduplicating by
+// label set: an instance already present in base, keyed by its labels, is
+// left untouched; any new label set from other is appended.
+func mergeAlertInstances(base, other []*storepb.AlertInstance) []*storepb.AlertInstance {
+	seen := make(map[string]struct{}, len(base))
+	for _, ai := range base {
+		seen[storepb.PromLabelsToLabels(&ai.Labels).String()] = struct{}{}
+	}
+
+	for _, ai := range other {
+		key := storepb.PromLabelsToLabels(&ai.Labels).String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		base = append(base, ai)
+	}
+	return base
+}
+
+// matchesRequest reports whether a rule group (and, transitively, its
+// rules) should be kept for the given RulesRequest filters.
+func matchesRequest(req *storepb.RulesRequest, g *storepb.RuleGroup) bool {
+	if len(req.File) > 0 && !contains(req.File, g.File) {
+		return false
+	}
+	if len(req.RuleGroup) > 0 && !contains(req.RuleGroup, g.Name) {
+		return false
+	}
+	return true
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, h := range haystack {
+		if h == needle {
+			return true
+		}
+	}
+	return false
+}
+
+// filterRules returns the subset of rules that match req's type
+// and rule_name filters.
+func filterRules(req *storepb.RulesRequest, rules []*storepb.Rule) []*storepb.Rule {
+	if req.Type == storepb.RulesRequest_ALL && len(req.RuleName) == 0 {
+		return rules
+	}
+
+	out := make([]*storepb.Rule, 0, len(rules))
+	for _, r := range rules {
+		var name string
+		switch {
+		case r.GetAlert() != nil:
+			if req.Type == storepb.RulesRequest_RECORD {
+				continue
+			}
+			name = r.GetAlert().Name
+		case r.GetRecording() != nil:
+			if req.Type == storepb.RulesRequest_ALERT {
+				continue
+			}
+			name = r.GetRecording().Name
+		default:
+			continue
+		}
+		if len(req.RuleName) > 0 && !contains(req.RuleName, name) {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
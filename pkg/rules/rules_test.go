@@ -0,0 +1,143 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/thanos-io/thanos/pkg/store/storepb"
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+func TestMergeGroups(t *testing.T) {
+	alertA := &storepb.Alert{
+		Name: "HighErrorRate",
+		Alerts: []*storepb.AlertInstance{
+			{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "a"}}}},
+		},
+	}
+	alertB := &storepb.Alert{
+		Name: "HighErrorRate",
+		Alerts: []*storepb.AlertInstance{
+			{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "b"}}}},
+		},
+	}
+
+	groupsFromSidecar1 := []*storepb.RuleGroup{
+		{
+			File: "alerts.yml",
+			Name: "example",
+			Rules: []*storepb.Rule{
+				{Result: &storepb.Rule_Alert{Alert: alertA}},
+			},
+		},
+	}
+	groupsFromSidecar2 := []*storepb.RuleGroup{
+		{
+			File: "alerts.yml",
+			Name: "example",
+			Rules: []*storepb.Rule{
+				{Result: &storepb.Rule_Alert{Alert: alertB}},
+			},
+		},
+	}
+
+	merged := mergeGroups(groupsFromSidecar1, groupsFromSidecar2)
+	testutil.Equals(t, 1, len(merged))
+	testutil.Equals(t, 1, len(merged[0].Rules))
+
+	mergedAlert := merged[0].Rules[0].GetAlert()
+	testutil.Equals(t, 2, len(mergedAlert.Alerts))
+
+	groupsFromSidecar3 := []*storepb.RuleGroup{
+		{File: "recording.yml", Name: "other"},
+	}
+	merged = mergeGroups(groupsFromSidecar1, groupsFromSidecar3)
+	testutil.Equals(t, 2, len(merged))
+}
+
+func TestMergeRules(t *testing.T) {
+	recordingRule := &storepb.Rule{Result: &storepb.Rule_Recording{Recording: &storepb.RecordingRule{Name: "job:requests:rate5m"}}}
+
+	base := []*storepb.Rule{
+		{Result: &storepb.Rule_Alert{Alert: &storepb.Alert{
+			Name: "HighErrorRate",
+			Alerts: []*storepb.AlertInstance{
+				{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "a"}}}},
+			},
+		}}},
+	}
+	other := []*storepb.Rule{
+		{Result: &storepb.Rule_Alert{Alert: &storepb.Alert{
+			Name: "HighErrorRate",
+			Alerts: []*storepb.AlertInstance{
+				// Same label set as base's instance: must not be duplicated.
+				{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "a"}}}},
+				{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "b"}}}},
+			},
+		}}},
+		recordingRule,
+	}
+
+	merged := mergeRules(base, other)
+	testutil.Equals(t, 2, len(merged))
+	testutil.Equals(t, 2, len(merged[0].GetAlert().Alerts))
+	testutil.Equals(t, recordingRule, merged[1])
+}
+
+func TestMergeAlertInstances(t *testing.T) {
+	base := []*storepb.AlertInstance{
+		{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "a"}}}},
+	}
+	other := []*storepb.AlertInstance{
+		{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "a"}}}},
+		{Labels: storepb.PromLabels{Labels: []storepb.Label{{Name: "job", Value: "b"}}}},
+	}
+
+	merged := mergeAlertInstances(base, other)
+	testutil.Equals(t, 2, len(merged))
+}
+
+func TestMatchesRequest(t *testing.T) {
+	group := &storepb.RuleGroup{File: "alerts.yml", Name: "example"}
+
+	for _, tcase := range []struct {
+		name     string
+		req      *storepb.RulesRequest
+		expected bool
+	}{
+		{name: "no filters", req: &storepb.RulesRequest{}, expected: true},
+		{name: "matching file", req: &storepb.RulesRequest{File: []string{"alerts.yml"}}, expected: true},
+		{name: "non-matching file", req: &storepb.RulesRequest{File: []string{"other.yml"}}, expected: false},
+		{name: "matching group", req: &storepb.RulesRequest{RuleGroup: []string{"example"}}, expected: true},
+		{name: "non-matching group", req: &storepb.RulesRequest{RuleGroup: []string{"other"}}, expected: false},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			testutil.Equals(t, tcase.expected, matchesRequest(tcase.req, group))
+		})
+	}
+}
+
+func TestFilterRules(t *testing.T) {
+	rules := []*storepb.Rule{
+		{Result: &storepb.Rule_Alert{Alert: &storepb.Alert{Name: "HighErrorRate"}}},
+		{Result: &storepb.Rule_Recording{Recording: &storepb.RecordingRule{Name: "job:requests:rate5m"}}},
+	}
+
+	for _, tcase := range []struct {
+		name     string
+		req      *storepb.RulesRequest
+		expected int
+	}{
+		{name: "all", req: &storepb.RulesRequest{Type: storepb.RulesRequest_ALL}, expected: 2},
+		{name: "alert only", req: &storepb.RulesRequest{Type: storepb.RulesRequest_ALERT}, expected: 1},
+		{name: "record only", req: &storepb.RulesRequest{Type: storepb.RulesRequest_RECORD}, expected: 1},
+		{name: "by name", req: &storepb.RulesRequest{RuleName: []string{"HighErrorRate"}}, expected: 1},
+		{name: "by unknown name", req: &storepb.RulesRequest{RuleName: []string{"bogus"}}, expected: 0},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			testutil.Equals(t, tcase.expected, len(filterRules(tcase.req, rules)))
+		})
+	}
+}
@@ -0,0 +1,613 @@
+// Hand-maintained stand-in for protoc-gen-gogo output — see wire.go.
+// Kept in sync with the .proto file by hand; it is not regenerated by protoc.
+// source: alerts.proto
+
+package storepb
+
+import (
+	context "context"
+	fmt "fmt"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+func init() {
+	proto.RegisterType((*AlertsRequest)(nil), "thanos.AlertsRequest")
+	proto.RegisterType((*AlertsResponse)(nil), "thanos.AlertsResponse")
+	proto.RegisterType((*AlertSet)(nil), "thanos.AlertSet")
+	proto.RegisterType((*ActiveAlert)(nil), "thanos.ActiveAlert")
+}
+
+type AlertsRequest struct {
+	PartialResponseStrategy PartialResponseStrategy `protobuf:"varint,1,opt,name=partial_response_strategy,json=partialResponseStrategy,proto3,enum=thanos.PartialResponseStrategy" json:"partial_response_strategy,omitempty"`
+}
+
+func (m *AlertsRequest) Reset()         { *m = AlertsRequest{} }
+func (m *AlertsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*AlertsRequest) ProtoMessage()    {}
+
+func (m *AlertsRequest) Equal(that interface{}) bool {
+	that1, ok := that.(*AlertsRequest)
+	if !ok {
+		that2, ok := that.(AlertsRequest)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	return m.PartialResponseStrategy == that1.PartialResponseStrategy
+}
+
+func (m *AlertsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	n += sizeVarintField(1, uint64(m.PartialResponseStrategy))
+	return n
+}
+
+func (m *AlertsRequest) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *AlertsRequest) MarshalTo(dAtA []byte) ([]byte, error) {
+	dAtA = appendVarintField(dAtA, 1, uint64(m.PartialResponseStrategy))
+	return dAtA, nil
+}
+
+func (m *AlertsRequest) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.PartialResponseStrategy = PartialResponseStrategy(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type AlertsResponse struct {
+	// Types that are valid to be assigned to Result:
+	//	*AlertsResponse_Alerts
+	//	*AlertsResponse_Warning
+	Result isAlertsResponse_Result `protobuf_oneof:"result"`
+}
+
+func (m *AlertsResponse) Reset()         { *m = AlertsResponse{} }
+func (m *AlertsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*AlertsResponse) ProtoMessage()    {}
+
+type isAlertsResponse_Result interface {
+	isAlertsResponse_Result()
+}
+
+type AlertsResponse_Alerts struct {
+	Alerts *AlertSet `protobuf:"bytes,1,opt,name=alerts,proto3,oneof"`
+}
+type AlertsResponse_Warning struct {
+	Warning string `protobuf:"bytes,2,opt,name=warning,proto3,oneof"`
+}
+
+func (*AlertsResponse_Alerts) isAlertsResponse_Result()  {}
+func (*AlertsResponse_Warning) isAlertsResponse_Result() {}
+
+func (m *AlertsResponse) GetResult() isAlertsResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *AlertsResponse) GetAlerts() *AlertSet {
+	if x, ok := m.GetResult().(*AlertsResponse_Alerts); ok {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (m *AlertsResponse) GetWarning() string {
+	if x, ok := m.GetResult().(*AlertsResponse_Warning); ok {
+		return x.Warning
+	}
+	return ""
+}
+
+func (m *AlertsResponse) Equal(that interface{}) bool {
+	that1, ok := that.(*AlertsResponse)
+	if !ok {
+		that2, ok := that.(AlertsResponse)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	switch r := m.Result.(type) {
+	case *AlertsResponse_Alerts:
+		o, ok := that1.Result.(*AlertsResponse_Alerts)
+		return ok && r.Alerts.Equal(o.Alerts)
+	case *AlertsResponse_Warning:
+		o, ok := that1.Result.(*AlertsResponse_Warning)
+		return ok && r.Warning == o.Warning
+	case nil:
+		return that1.Result == nil
+	default:
+		return false
+	}
+}
+
+func (m *AlertsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	switch r := m.Result.(type) {
+	case *AlertsResponse_Alerts:
+		n += sizeMessageField(1, r.Alerts.Size())
+	case *AlertsResponse_Warning:
+		n += sizeStringField(2, r.Warning)
+	}
+	return n
+}
+
+func (m *AlertsResponse) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *AlertsResponse) MarshalTo(dAtA []byte) ([]byte, error) {
+	switch r := m.Result.(type) {
+	case *AlertsResponse_Alerts:
+		sub, err := r.Alerts.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 1, sub)
+	case *AlertsResponse_Warning:
+		dAtA = appendStringField(dAtA, 2, r.Warning)
+	}
+	return dAtA, nil
+}
+
+func (m *AlertsResponse) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			a := &AlertSet{}
+			if err := a.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Result = &AlertsResponse_Alerts{Alerts: a}
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Result = &AlertsResponse_Warning{Warning: string(b)}
+			i = end
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// NewAlertsResponse creates a new AlertsResponse with an AlertSet.
+func NewAlertsResponse(alerts *AlertSet) *AlertsResponse {
+	return &AlertsResponse{
+		Result: &AlertsResponse_Alerts{Alerts: alerts},
+	}
+}
+
+// NewWarningAlertsResponse creates a new AlertsResponse with a warning.
+func NewWarningAlertsResponse(warning error) *AlertsResponse {
+	return &AlertsResponse{
+		Result: &AlertsResponse_Warning{Warning: warning.Error()},
+	}
+}
+
+// AlertSet groups currently active alerts, mirroring the `{alerts: [...]}`
+// "data" field of Prometheus's /api/v1/alerts response.
+type AlertSet struct {
+	Alerts                  []*ActiveAlert          `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	PartialResponseStrategy PartialResponseStrategy `protobuf:"varint,2,opt,name=partial_response_strategy,json=partialResponseStrategy,proto3,enum=thanos.PartialResponseStrategy" json:"partial_response_strategy,omitempty"`
+}
+
+func (m *AlertSet) Reset()         { *m = AlertSet{} }
+func (m *AlertSet) String() string { return fmt.Sprintf("%+v", *m) }
+func (*AlertSet) ProtoMessage()    {}
+
+func (m *AlertSet) GetAlerts() []*ActiveAlert {
+	if m != nil {
+		return m.Alerts
+	}
+	return nil
+}
+
+func (m *AlertSet) Equal(that interface{}) bool {
+	that1, ok := that.(*AlertSet)
+	if !ok {
+		that2, ok := that.(AlertSet)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if m.PartialResponseStrategy != that1.PartialResponseStrategy {
+		return false
+	}
+	if len(m.Alerts) != len(that1.Alerts) {
+		return false
+	}
+	for i := range m.Alerts {
+		if !m.Alerts[i].Equal(that1.Alerts[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *AlertSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	for _, a := range m.Alerts {
+		n += sizeMessageField(1, a.Size())
+	}
+	n += sizeVarintField(2, uint64(m.PartialResponseStrategy))
+	return n
+}
+
+func (m *AlertSet) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *AlertSet) MarshalTo(dAtA []byte) ([]byte, error) {
+	for _, a := range m.Alerts {
+		sub, err := a.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 1, sub)
+	}
+	dAtA = appendVarintField(dAtA, 2, uint64(m.PartialResponseStrategy))
+	return dAtA, nil
+}
+
+func (m *AlertSet) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			a := &ActiveAlert{}
+			if err := a.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Alerts = append(m.Alerts, a)
+			i = end
+		case 2:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.PartialResponseStrategy = PartialResponseStrategy(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type ActiveAlert struct {
+	Labels      *PromLabels `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels"`
+	Annotations *PromLabels `protobuf:"bytes,2,opt,name=annotations,proto3" json:"annotations"`
+	State       AlertState  `protobuf:"varint,3,opt,name=state,proto3,enum=thanos.AlertState" json:"state,omitempty"`
+	ActiveAt    *time.Time  `protobuf:"bytes,4,opt,name=active_at,json=activeAt,proto3,stdtime" json:"active_at,omitempty"`
+	Value       string      `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ActiveAlert) Reset()         { *m = ActiveAlert{} }
+func (m *ActiveAlert) String() string { return fmt.Sprintf("%+v", *m) }
+func (*ActiveAlert) ProtoMessage()    {}
+
+func (m *ActiveAlert) Equal(that interface{}) bool {
+	that1, ok := that.(*ActiveAlert)
+	if !ok {
+		that2, ok := that.(ActiveAlert)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if !m.Labels.Equal(that1.Labels) || !m.Annotations.Equal(that1.Annotations) ||
+		m.State != that1.State || m.Value != that1.Value {
+		return false
+	}
+	if (m.ActiveAt == nil) != (that1.ActiveAt == nil) {
+		return false
+	}
+	if m.ActiveAt != nil && !timeEqual(*m.ActiveAt, *that1.ActiveAt) {
+		return false
+	}
+	return true
+}
+
+func (m *ActiveAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Labels != nil {
+		n += sizeMessageFieldAlways(1, m.Labels.Size())
+	}
+	if m.Annotations != nil {
+		n += sizeMessageFieldAlways(2, m.Annotations.Size())
+	}
+	n += sizeVarintField(3, uint64(m.State))
+	if m.ActiveAt != nil {
+		n += sizeMessageField(4, len(marshalStdTime(*m.ActiveAt)))
+	}
+	n += sizeStringField(5, m.Value)
+	return n
+}
+
+func (m *ActiveAlert) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *ActiveAlert) MarshalTo(dAtA []byte) ([]byte, error) {
+	if m.Labels != nil {
+		sub, err := m.Labels.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageFieldAlways(dAtA, 1, sub)
+	}
+	if m.Annotations != nil {
+		sub, err := m.Annotations.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageFieldAlways(dAtA, 2, sub)
+	}
+	dAtA = appendVarintField(dAtA, 3, uint64(m.State))
+	if m.ActiveAt != nil {
+		dAtA = appendMessageField(dAtA, 4, marshalStdTime(*m.ActiveAt))
+	}
+	dAtA = appendStringField(dAtA, 5, m.Value)
+	return dAtA, nil
+}
+
+func (m *ActiveAlert) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Labels = &PromLabels{}
+			if err := m.Labels.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Annotations = &PromLabels{}
+			if err := m.Annotations.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 3:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.State = AlertState(v)
+		case 4:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			t, err := unmarshalStdTime(b)
+			if err != nil {
+				return err
+			}
+			m.ActiveAt = &t
+			i = end
+		case 5:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Value = string(b)
+			i = end
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// AlertsClient is the client API for the Alerts service.
+type AlertsClient interface {
+	Alerts(ctx context.Context, in *AlertsRequest, opts ...grpc.CallOption) (Alerts_AlertsClient, error)
+}
+
+type alertsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAlertsClient(cc *grpc.ClientConn) AlertsClient {
+	return &alertsClient{cc}
+}
+
+func (c *alertsClient) Alerts(ctx context.Context, in *AlertsRequest, opts ...grpc.CallOption) (Alerts_AlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Alerts_serviceDesc.Streams[0], "/thanos.Alerts/Alerts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &alertsAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Alerts_AlertsClient interface {
+	Recv() (*AlertsResponse, error)
+	grpc.ClientStream
+}
+
+type alertsAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *alertsAlertsClient) Recv() (*AlertsResponse, error) {
+	m := new(AlertsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AlertsServer is the server API for the Alerts service.
+type AlertsServer interface {
+	Alerts(*AlertsRequest, Alerts_AlertsServer) error
+}
+
+// UnimplementedAlertsServer can be embedded to have forward compatible implementations.
+type UnimplementedAlertsServer struct{}
+
+func (*UnimplementedAlertsServer) Alerts(*AlertsRequest, Alerts_AlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Alerts not implemented")
+}
+
+func RegisterAlertsServer(s *grpc.Server, srv AlertsServer) {
+	s.RegisterService(&_Alerts_serviceDesc, srv)
+}
+
+func _Alerts_Alerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertsServer).Alerts(m, &alertsAlertsServer{stream})
+}
+
+type Alerts_AlertsServer interface {
+	Send(*AlertsResponse) error
+	grpc.ServerStream
+}
+
+type alertsAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *alertsAlertsServer) Send(m *AlertsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Alerts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "thanos.Alerts",
+	HandlerType: (*AlertsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Alerts",
+			Handler:       _Alerts_Alerts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "alerts.proto",
+}
@@ -0,0 +1,89 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+type activeAlertJSON struct {
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	State       string        `json:"state"`
+	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
+	Value       string        `json:"value"`
+}
+
+type alertSetJSON struct {
+	Alerts []*activeAlertJSON `json:"alerts"`
+
+	PartialResponseStrategy string `json:"partialResponseStrategy,omitempty"`
+}
+
+// UnmarshalJSON unmarshals AlertSet from the `{"alerts": [...]}` shape of
+// the "data" field in Prometheus's /api/v1/alerts response.
+func (m *AlertSet) UnmarshalJSON(b []byte) error {
+	var raw alertSetJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	strategy, err := parsePartialResponseStrategy(raw.PartialResponseStrategy)
+	if err != nil {
+		return err
+	}
+
+	var alerts []*ActiveAlert
+	if len(raw.Alerts) > 0 {
+		alerts = make([]*ActiveAlert, 0, len(raw.Alerts))
+		for _, a := range raw.Alerts {
+			state, err := parseAlertState(a.State)
+			if err != nil {
+				return errors.Wrapf(err, "alert: unmarshal")
+			}
+			alerts = append(alerts, &ActiveAlert{
+				Labels:      LabelsToPromLabels(a.Labels),
+				Annotations: LabelsToPromLabels(a.Annotations),
+				State:       state,
+				ActiveAt:    a.ActiveAt,
+				Value:       a.Value,
+			})
+		}
+	}
+
+	m.Alerts = alerts
+	m.PartialResponseStrategy = strategy
+	return nil
+}
+
+// MarshalJSON marshals AlertSet back into the same shape it was decoded
+// from.
+func (m *AlertSet) MarshalJSON() ([]byte, error) {
+	var alerts []*activeAlertJSON
+	if len(m.Alerts) > 0 {
+		alerts = make([]*activeAlertJSON, 0, len(m.Alerts))
+		for _, a := range m.Alerts {
+			alerts = append(alerts, &activeAlertJSON{
+				Labels:      PromLabelsToLabels(a.Labels),
+				Annotations: PromLabelsToLabels(a.Annotations),
+				State:       a.State.String(),
+				ActiveAt:    a.ActiveAt,
+				Value:       a.Value,
+			})
+		}
+	}
+
+	strategy := m.PartialResponseStrategy.String()
+	if m.PartialResponseStrategy == PartialResponseStrategy_WARN {
+		strategy = ""
+	}
+	return json.Marshal(alertSetJSON{
+		Alerts:                  alerts,
+		PartialResponseStrategy: strategy,
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+// prometheusActiveAlert is a sibling of the prometheusAlert fixture in
+// rules_custom_test.go, minus the per-rule partialResponseStrategy field
+// that only applies to alerts nested under a rule.
+type prometheusActiveAlert struct {
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	State       string        `json:"state"`
+	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
+	Value       string        `json:"value"`
+}
+
+// prometheusAlertsDiscovery is a sibling of prometheusRuleDiscovery in
+// rules_custom_test.go, matching the `{alerts:[...]}` object in the "data"
+// field of Prometheus's /api/v1/alerts response.
+type prometheusAlertsDiscovery struct {
+	Alerts []*prometheusActiveAlert `json:"alerts"`
+
+	PartialResponseStrategy string `json:"partialResponseStrategy,omitempty"`
+}
+
+func TestAlertSetJSONUnmarshalMarshal(t *testing.T) {
+	now := time.Now()
+
+	for _, tcase := range []struct {
+		name  string
+		input *prometheusAlertsDiscovery
+
+		expectedProto *AlertSet
+		expectedErr   error
+	}{
+		{
+			name:          "no alerts",
+			input:         &prometheusAlertsDiscovery{},
+			expectedProto: &AlertSet{PartialResponseStrategy: PartialResponseStrategy_WARN},
+		},
+		{
+			name: "one alert, default (WARN) partial response strategy",
+			input: &prometheusAlertsDiscovery{
+				Alerts: []*prometheusActiveAlert{
+					{
+						Labels:      labels.Labels{{Name: "alertname", Value: "HighErrorRate"}},
+						Annotations: labels.Labels{{Name: "summary", Value: "error rate is high"}},
+						State:       "FIRING",
+						ActiveAt:    &now,
+						Value:       "1.5e+01",
+					},
+				},
+			},
+			expectedProto: &AlertSet{
+				Alerts: []*ActiveAlert{
+					{
+						Labels:      &PromLabels{Labels: []Label{{Name: "alertname", Value: "HighErrorRate"}}},
+						Annotations: &PromLabels{Labels: []Label{{Name: "summary", Value: "error rate is high"}}},
+						State:       AlertState_FIRING,
+						ActiveAt:    &now,
+						Value:       "1.5e+01",
+					},
+				},
+				PartialResponseStrategy: PartialResponseStrategy_WARN,
+			},
+		},
+		{
+			name: "multiple alerts, ABORT partial response strategy",
+			input: &prometheusAlertsDiscovery{
+				Alerts: []*prometheusActiveAlert{
+					{
+						Labels: labels.Labels{{Name: "alertname", Value: "A"}},
+						State:  "PENDING",
+						Value:  "1",
+					},
+					{
+						Labels: labels.Labels{{Name: "alertname", Value: "B"}},
+						State:  "INACTIVE",
+						Value:  "0",
+					},
+				},
+				PartialResponseStrategy: "ABORT",
+			},
+			expectedProto: &AlertSet{
+				Alerts: []*ActiveAlert{
+					{
+						Labels:      &PromLabels{Labels: []Label{{Name: "alertname", Value: "A"}}},
+						Annotations: &PromLabels{},
+						State:       AlertState_PENDING,
+						Value:       "1",
+					},
+					{
+						Labels:      &PromLabels{Labels: []Label{{Name: "alertname", Value: "B"}}},
+						Annotations: &PromLabels{},
+						State:       AlertState_INACTIVE,
+						Value:       "0",
+					},
+				},
+				PartialResponseStrategy: PartialResponseStrategy_ABORT,
+			},
+		},
+		{
+			name: "unknown alert state",
+			input: &prometheusAlertsDiscovery{
+				Alerts: []*prometheusActiveAlert{
+					{
+						Labels: labels.Labels{{Name: "alertname", Value: "A"}},
+						State:  "bogus",
+						Value:  "1",
+					},
+				},
+			},
+			expectedErr: errors.New(`alert: unmarshal: unknown alertState: "bogus"`),
+		},
+		{
+			name: "unknown partial response strategy",
+			input: &prometheusAlertsDiscovery{
+				PartialResponseStrategy: "bogus",
+			},
+			expectedErr: errors.New(`unknown partialResponseStrategy: "bogus"`),
+		},
+	} {
+		if ok := t.Run(tcase.name, func(t *testing.T) {
+			jsonInput, err := json.Marshal(tcase.input)
+			testutil.Ok(t, err)
+
+			proto := &AlertSet{}
+			err = json.Unmarshal(jsonInput, proto)
+			if tcase.expectedErr != nil {
+				testutil.NotOk(t, err)
+				testutil.Equals(t, tcase.expectedErr.Error(), err.Error())
+				return
+			}
+			testutil.Ok(t, err)
+			testutil.Equals(t, tcase.expectedProto.String(), proto.String())
+
+			jsonProto, err := json.Marshal(proto)
+			testutil.Ok(t, err)
+			testutil.Equals(t, jsonInput, jsonProto)
+		}); !ok {
+			return
+		}
+	}
+}
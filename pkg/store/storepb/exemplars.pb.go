@@ -0,0 +1,613 @@
+// Hand-maintained stand-in for protoc-gen-gogo output — see wire.go.
+// Kept in sync with the .proto file by hand; it is not regenerated by protoc.
+// source: exemplars.proto
+
+package storepb
+
+import (
+	context "context"
+	fmt "fmt"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+func init() {
+	proto.RegisterType((*ExemplarsRequest)(nil), "thanos.ExemplarsRequest")
+	proto.RegisterType((*ExemplarsResponse)(nil), "thanos.ExemplarsResponse")
+	proto.RegisterType((*ExemplarData)(nil), "thanos.ExemplarData")
+	proto.RegisterType((*Exemplar)(nil), "thanos.Exemplar")
+}
+
+type ExemplarsRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+
+	Start int64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+
+	PartialResponseStrategy PartialResponseStrategy `protobuf:"varint,4,opt,name=partial_response_strategy,json=partialResponseStrategy,proto3,enum=thanos.PartialResponseStrategy" json:"partial_response_strategy,omitempty"`
+}
+
+func (m *ExemplarsRequest) Reset()         { *m = ExemplarsRequest{} }
+func (m *ExemplarsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*ExemplarsRequest) ProtoMessage()    {}
+
+func (m *ExemplarsRequest) Equal(that interface{}) bool {
+	that1, ok := that.(*ExemplarsRequest)
+	if !ok {
+		that2, ok := that.(ExemplarsRequest)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	return m.Query == that1.Query && m.Start == that1.Start && m.End == that1.End &&
+		m.PartialResponseStrategy == that1.PartialResponseStrategy
+}
+
+func (m *ExemplarsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	n += sizeStringField(1, m.Query)
+	n += sizeVarintField(2, uint64(m.Start))
+	n += sizeVarintField(3, uint64(m.End))
+	n += sizeVarintField(4, uint64(m.PartialResponseStrategy))
+	return n
+}
+
+func (m *ExemplarsRequest) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *ExemplarsRequest) MarshalTo(dAtA []byte) ([]byte, error) {
+	dAtA = appendStringField(dAtA, 1, m.Query)
+	dAtA = appendVarintField(dAtA, 2, uint64(m.Start))
+	dAtA = appendVarintField(dAtA, 3, uint64(m.End))
+	dAtA = appendVarintField(dAtA, 4, uint64(m.PartialResponseStrategy))
+	return dAtA, nil
+}
+
+func (m *ExemplarsRequest) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Query = string(b)
+			i = end
+		case 2:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Start = int64(v)
+		case 3:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.End = int64(v)
+		case 4:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.PartialResponseStrategy = PartialResponseStrategy(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type ExemplarsResponse struct {
+	// Types that are valid to be assigned to Result:
+	//	*ExemplarsResponse_Data
+	//	*ExemplarsResponse_Warning
+	Result isExemplarsResponse_Result `protobuf_oneof:"result"`
+}
+
+func (m *ExemplarsResponse) Reset()         { *m = ExemplarsResponse{} }
+func (m *ExemplarsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*ExemplarsResponse) ProtoMessage()    {}
+
+type isExemplarsResponse_Result interface {
+	isExemplarsResponse_Result()
+}
+
+type ExemplarsResponse_Data struct {
+	Data *ExemplarData `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+type ExemplarsResponse_Warning struct {
+	Warning string `protobuf:"bytes,2,opt,name=warning,proto3,oneof"`
+}
+
+func (*ExemplarsResponse_Data) isExemplarsResponse_Result()    {}
+func (*ExemplarsResponse_Warning) isExemplarsResponse_Result() {}
+
+func (m *ExemplarsResponse) GetResult() isExemplarsResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ExemplarsResponse) GetData() *ExemplarData {
+	if x, ok := m.GetResult().(*ExemplarsResponse_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (m *ExemplarsResponse) GetWarning() string {
+	if x, ok := m.GetResult().(*ExemplarsResponse_Warning); ok {
+		return x.Warning
+	}
+	return ""
+}
+
+func (m *ExemplarsResponse) Equal(that interface{}) bool {
+	that1, ok := that.(*ExemplarsResponse)
+	if !ok {
+		that2, ok := that.(ExemplarsResponse)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	switch r := m.Result.(type) {
+	case *ExemplarsResponse_Data:
+		o, ok := that1.Result.(*ExemplarsResponse_Data)
+		return ok && r.Data.Equal(o.Data)
+	case *ExemplarsResponse_Warning:
+		o, ok := that1.Result.(*ExemplarsResponse_Warning)
+		return ok && r.Warning == o.Warning
+	case nil:
+		return that1.Result == nil
+	default:
+		return false
+	}
+}
+
+func (m *ExemplarsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	switch r := m.Result.(type) {
+	case *ExemplarsResponse_Data:
+		n += sizeMessageField(1, r.Data.Size())
+	case *ExemplarsResponse_Warning:
+		n += sizeStringField(2, r.Warning)
+	}
+	return n
+}
+
+func (m *ExemplarsResponse) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *ExemplarsResponse) MarshalTo(dAtA []byte) ([]byte, error) {
+	switch r := m.Result.(type) {
+	case *ExemplarsResponse_Data:
+		sub, err := r.Data.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 1, sub)
+	case *ExemplarsResponse_Warning:
+		dAtA = appendStringField(dAtA, 2, r.Warning)
+	}
+	return dAtA, nil
+}
+
+func (m *ExemplarsResponse) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			d := &ExemplarData{}
+			if err := d.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Result = &ExemplarsResponse_Data{Data: d}
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Result = &ExemplarsResponse_Warning{Warning: string(b)}
+			i = end
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// NewExemplarsResponse creates a new ExemplarsResponse with data.
+func NewExemplarsResponse(data *ExemplarData) *ExemplarsResponse {
+	return &ExemplarsResponse{
+		Result: &ExemplarsResponse_Data{Data: data},
+	}
+}
+
+// NewWarningExemplarsResponse creates a new ExemplarsResponse with a warning.
+func NewWarningExemplarsResponse(warning error) *ExemplarsResponse {
+	return &ExemplarsResponse{
+		Result: &ExemplarsResponse_Warning{Warning: warning.Error()},
+	}
+}
+
+// ExemplarData groups the exemplars sharing a single series.
+type ExemplarData struct {
+	SeriesLabels *PromLabels `protobuf:"bytes,1,opt,name=seriesLabels,proto3" json:"seriesLabels"`
+	Exemplars    []*Exemplar `protobuf:"bytes,2,rep,name=exemplars,proto3" json:"exemplars,omitempty"`
+}
+
+func (m *ExemplarData) Reset()         { *m = ExemplarData{} }
+func (m *ExemplarData) String() string { return fmt.Sprintf("%+v", *m) }
+func (*ExemplarData) ProtoMessage()    {}
+
+func (m *ExemplarData) GetSeriesLabels() *PromLabels {
+	if m != nil {
+		return m.SeriesLabels
+	}
+	return nil
+}
+
+func (m *ExemplarData) GetExemplars() []*Exemplar {
+	if m != nil {
+		return m.Exemplars
+	}
+	return nil
+}
+
+func (m *ExemplarData) Equal(that interface{}) bool {
+	that1, ok := that.(*ExemplarData)
+	if !ok {
+		that2, ok := that.(ExemplarData)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if !m.SeriesLabels.Equal(that1.SeriesLabels) {
+		return false
+	}
+	if len(m.Exemplars) != len(that1.Exemplars) {
+		return false
+	}
+	for i := range m.Exemplars {
+		if !m.Exemplars[i].Equal(that1.Exemplars[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *ExemplarData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.SeriesLabels != nil {
+		n += sizeMessageFieldAlways(1, m.SeriesLabels.Size())
+	}
+	for _, e := range m.Exemplars {
+		n += sizeMessageField(2, e.Size())
+	}
+	return n
+}
+
+func (m *ExemplarData) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *ExemplarData) MarshalTo(dAtA []byte) ([]byte, error) {
+	if m.SeriesLabels != nil {
+		sub, err := m.SeriesLabels.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageFieldAlways(dAtA, 1, sub)
+	}
+	for _, e := range m.Exemplars {
+		sub, err := e.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 2, sub)
+	}
+	return dAtA, nil
+}
+
+func (m *ExemplarData) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.SeriesLabels = &PromLabels{}
+			if err := m.SeriesLabels.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			e := &Exemplar{}
+			if err := e.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Exemplars = append(m.Exemplars, e)
+			i = end
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type Exemplar struct {
+	Labels    *PromLabels `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels"`
+	Value     float64     `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp int64       `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Exemplar) Reset()         { *m = Exemplar{} }
+func (m *Exemplar) String() string { return fmt.Sprintf("%+v", *m) }
+func (*Exemplar) ProtoMessage()    {}
+
+func (m *Exemplar) Equal(that interface{}) bool {
+	that1, ok := that.(*Exemplar)
+	if !ok {
+		that2, ok := that.(Exemplar)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	return m.Labels.Equal(that1.Labels) && m.Value == that1.Value && m.Timestamp == that1.Timestamp
+}
+
+func (m *Exemplar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if m.Labels != nil {
+		n += sizeMessageField(1, m.Labels.Size())
+	}
+	n += sizeFixed64Field(2, m.Value)
+	n += sizeVarintField(3, uint64(m.Timestamp))
+	return n
+}
+
+func (m *Exemplar) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *Exemplar) MarshalTo(dAtA []byte) ([]byte, error) {
+	if m.Labels != nil {
+		sub, err := m.Labels.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 1, sub)
+	}
+	dAtA = appendFixed64Field(dAtA, 2, m.Value)
+	dAtA = appendVarintField(dAtA, 3, uint64(m.Timestamp))
+	return dAtA, nil
+}
+
+func (m *Exemplar) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Labels = &PromLabels{}
+			if err := m.Labels.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 2:
+			v, err := readFixed64(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Value = v
+			i += 8
+		case 3:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Timestamp = int64(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// ExemplarsClient is the client API for the Exemplars service.
+type ExemplarsClient interface {
+	Exemplars(ctx context.Context, in *ExemplarsRequest, opts ...grpc.CallOption) (Exemplars_ExemplarsClient, error)
+}
+
+type exemplarsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExemplarsClient(cc *grpc.ClientConn) ExemplarsClient {
+	return &exemplarsClient{cc}
+}
+
+func (c *exemplarsClient) Exemplars(ctx context.Context, in *ExemplarsRequest, opts ...grpc.CallOption) (Exemplars_ExemplarsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Exemplars_serviceDesc.Streams[0], "/thanos.Exemplars/Exemplars", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exemplarsExemplarsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Exemplars_ExemplarsClient interface {
+	Recv() (*ExemplarsResponse, error)
+	grpc.ClientStream
+}
+
+type exemplarsExemplarsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exemplarsExemplarsClient) Recv() (*ExemplarsResponse, error) {
+	m := new(ExemplarsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExemplarsServer is the server API for the Exemplars service.
+type ExemplarsServer interface {
+	Exemplars(*ExemplarsRequest, Exemplars_ExemplarsServer) error
+}
+
+// UnimplementedExemplarsServer can be embedded to have forward compatible implementations.
+type UnimplementedExemplarsServer struct{}
+
+func (*UnimplementedExemplarsServer) Exemplars(*ExemplarsRequest, Exemplars_ExemplarsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Exemplars not implemented")
+}
+
+func RegisterExemplarsServer(s *grpc.Server, srv ExemplarsServer) {
+	s.RegisterService(&_Exemplars_serviceDesc, srv)
+}
+
+func _Exemplars_Exemplars_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExemplarsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExemplarsServer).Exemplars(m, &exemplarsExemplarsServer{stream})
+}
+
+type Exemplars_ExemplarsServer interface {
+	Send(*ExemplarsResponse) error
+	grpc.ServerStream
+}
+
+type exemplarsExemplarsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exemplarsExemplarsServer) Send(m *ExemplarsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Exemplars_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "thanos.Exemplars",
+	HandlerType: (*ExemplarsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Exemplars",
+			Handler:       _Exemplars_Exemplars_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "exemplars.proto",
+}
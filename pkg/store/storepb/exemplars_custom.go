@@ -0,0 +1,110 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+type exemplarDataJSON struct {
+	SeriesLabels json.RawMessage `json:"seriesLabels"`
+	Exemplars    []*exemplarJSON `json:"exemplars"`
+}
+
+// UnmarshalJSON unmarshals ExemplarData from the same
+// `{seriesLabels, exemplars:[...]}` shape Prometheus's
+// /api/v1/query_exemplars endpoint returns per series.
+func (m *ExemplarData) UnmarshalJSON(b []byte) error {
+	var raw exemplarDataJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	if len(raw.SeriesLabels) == 0 || string(raw.SeriesLabels) == "null" {
+		return errors.New("exemplar data: missing series selector (seriesLabels)")
+	}
+	var lset labels.Labels
+	if err := json.Unmarshal(raw.SeriesLabels, &lset); err != nil {
+		return errors.Wrap(err, "exemplar data: seriesLabels unmarshal")
+	}
+
+	var exemplars []*Exemplar
+	if len(raw.Exemplars) > 0 {
+		exemplars = make([]*Exemplar, 0, len(raw.Exemplars))
+		for _, e := range raw.Exemplars {
+			ex, err := e.toProto()
+			if err != nil {
+				return err
+			}
+			exemplars = append(exemplars, ex)
+		}
+	}
+
+	m.SeriesLabels = LabelsToPromLabels(lset)
+	m.Exemplars = exemplars
+	return nil
+}
+
+// MarshalJSON marshals ExemplarData back into the same shape it was
+// decoded from.
+func (m *ExemplarData) MarshalJSON() ([]byte, error) {
+	var exemplars []*exemplarJSON
+	if len(m.Exemplars) > 0 {
+		exemplars = make([]*exemplarJSON, 0, len(m.Exemplars))
+		for _, e := range m.Exemplars {
+			exemplars = append(exemplars, e.toJSON())
+		}
+	}
+	return json.Marshal(exemplarDataMarshalJSON{
+		SeriesLabels: PromLabelsToLabels(m.SeriesLabels),
+		Exemplars:    exemplars,
+	})
+}
+
+type exemplarDataMarshalJSON struct {
+	SeriesLabels labels.Labels   `json:"seriesLabels"`
+	Exemplars    []*exemplarJSON `json:"exemplars"`
+}
+
+type exemplarJSON struct {
+	Labels    labels.Labels   `json:"labels"`
+	Value     string          `json:"value"`
+	Timestamp json.RawMessage `json:"timestamp"`
+}
+
+// toProto decodes a single exemplar entry, reporting precise errors for a
+// malformed timestamp or value rather than letting a generic encoding/json
+// error bubble up from the enclosing ExemplarData document.
+func (e *exemplarJSON) toProto() (*Exemplar, error) {
+	var ts float64
+	if err := json.Unmarshal(e.Timestamp, &ts); err != nil {
+		return nil, errors.Wrapf(err, "exemplar: malformed timestamp: %s", e.Timestamp)
+	}
+
+	val, err := strconv.ParseFloat(e.Value, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "exemplar: malformed value: %q", e.Value)
+	}
+
+	return &Exemplar{
+		Labels:    LabelsToPromLabels(e.Labels),
+		Value:     val,
+		Timestamp: int64(ts * 1000),
+	}, nil
+}
+
+// toJSON renders an Exemplar back into the {labels,value,timestamp} shape,
+// with value quoted and timestamp as seconds since epoch, matching
+// Prometheus's own /api/v1/query_exemplars marshaling.
+func (m *Exemplar) toJSON() *exemplarJSON {
+	return &exemplarJSON{
+		Labels:    PromLabelsToLabels(m.Labels),
+		Value:     strconv.FormatFloat(m.Value, 'f', -1, 64),
+		Timestamp: json.RawMessage(strconv.FormatFloat(float64(m.Timestamp)/1000, 'f', -1, 64)),
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+func TestExemplarDataJSONUnmarshalMarshal(t *testing.T) {
+	for _, tcase := range []struct {
+		name  string
+		input string
+
+		expectedProto *ExemplarData
+		expectedErr   error
+	}{
+		{
+			name:  "missing seriesLabels",
+			input: `{"exemplars":[]}`,
+
+			expectedErr: errors.New("exemplar data: missing series selector (seriesLabels)"),
+		},
+		{
+			name:  "malformed timestamp",
+			input: `{"seriesLabels":{"__name__":"http_request_duration_seconds_bucket"},"exemplars":[{"labels":{"trace_id":"abc"},"value":"0.003","timestamp":"not-a-number"}]}`,
+
+			expectedErr: errors.New(`exemplar: malformed timestamp: "not-a-number": json: cannot unmarshal string into Go value of type float64`),
+		},
+		{
+			name:  "no exemplars",
+			input: `{"seriesLabels":{"__name__":"http_request_duration_seconds_bucket"},"exemplars":[]}`,
+
+			expectedProto: &ExemplarData{
+				SeriesLabels: &PromLabels{
+					Labels: []Label{{Name: "__name__", Value: "http_request_duration_seconds_bucket"}},
+				},
+			},
+		},
+		{
+			name:  "one exemplar",
+			input: `{"seriesLabels":{"__name__":"http_request_duration_seconds_bucket","job":"foo"},"exemplars":[{"labels":{"trace_id":"abc123"},"value":"0.003","timestamp":1600096945.479}]}`,
+
+			expectedProto: &ExemplarData{
+				SeriesLabels: &PromLabels{
+					Labels: []Label{
+						{Name: "__name__", Value: "http_request_duration_seconds_bucket"},
+						{Name: "job", Value: "foo"},
+					},
+				},
+				Exemplars: []*Exemplar{
+					{
+						Labels:    &PromLabels{Labels: []Label{{Name: "trace_id", Value: "abc123"}}},
+						Value:     0.003,
+						Timestamp: 1600096945479,
+					},
+				},
+			},
+		},
+	} {
+		if ok := t.Run(tcase.name, func(t *testing.T) {
+			proto := &ExemplarData{}
+			err := json.Unmarshal([]byte(tcase.input), proto)
+			if tcase.expectedErr != nil {
+				testutil.NotOk(t, err)
+				testutil.Equals(t, tcase.expectedErr.Error(), err.Error())
+				return
+			}
+			testutil.Ok(t, err)
+			testutil.Equals(t, tcase.expectedProto.String(), proto.String())
+
+			jsonProto, err := json.Marshal(proto)
+			testutil.Ok(t, err)
+
+			roundTripped := &ExemplarData{}
+			testutil.Ok(t, json.Unmarshal(jsonProto, roundTripped))
+			testutil.Equals(t, tcase.expectedProto.String(), roundTripped.String())
+		}); !ok {
+			return
+		}
+	}
+}
@@ -0,0 +1,1424 @@
+// Hand-maintained stand-in for protoc-gen-gogo output — see wire.go.
+// Kept in sync with the .proto file by hand; it is not regenerated by protoc.
+// source: rules.proto
+
+package storepb
+
+import (
+	context "context"
+	fmt "fmt"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+func init() {
+	proto.RegisterEnum("thanos.AlertState", AlertState_name, AlertState_value)
+	proto.RegisterEnum("thanos.RulesRequest_Type", RulesRequest_Type_name, RulesRequest_Type_value)
+	proto.RegisterType((*RulesRequest)(nil), "thanos.RulesRequest")
+	proto.RegisterType((*RulesResponse)(nil), "thanos.RulesResponse")
+	proto.RegisterType((*RuleGroups)(nil), "thanos.RuleGroups")
+	proto.RegisterType((*RuleGroup)(nil), "thanos.RuleGroup")
+	proto.RegisterType((*Rule)(nil), "thanos.Rule")
+	proto.RegisterType((*RecordingRule)(nil), "thanos.RecordingRule")
+	proto.RegisterType((*Alert)(nil), "thanos.Alert")
+	proto.RegisterType((*AlertInstance)(nil), "thanos.AlertInstance")
+}
+
+// AlertState mirrors github.com/prometheus/prometheus/rules.AlertState.
+type AlertState int32
+
+const (
+	AlertState_INACTIVE AlertState = 0
+	AlertState_PENDING  AlertState = 1
+	AlertState_FIRING   AlertState = 2
+)
+
+var AlertState_name = map[int32]string{
+	0: "INACTIVE",
+	1: "PENDING",
+	2: "FIRING",
+}
+
+var AlertState_value = map[string]int32{
+	"INACTIVE": 0,
+	"PENDING":  1,
+	"FIRING":   2,
+}
+
+func (x AlertState) String() string {
+	return EnumName(AlertState_name, int32(x))
+}
+
+type RulesRequest_Type int32
+
+const (
+	RulesRequest_ALL    RulesRequest_Type = 0
+	RulesRequest_ALERT  RulesRequest_Type = 1
+	RulesRequest_RECORD RulesRequest_Type = 2
+)
+
+var RulesRequest_Type_name = map[int32]string{
+	0: "ALL",
+	1: "ALERT",
+	2: "RECORD",
+}
+
+var RulesRequest_Type_value = map[string]int32{
+	"ALL":    0,
+	"ALERT":  1,
+	"RECORD": 2,
+}
+
+func (x RulesRequest_Type) String() string {
+	return EnumName(RulesRequest_Type_name, int32(x))
+}
+
+// RulesRequest selects which rule groups a Rules server should respond with.
+type RulesRequest struct {
+	Type RulesRequest_Type `protobuf:"varint,1,opt,name=type,proto3,enum=thanos.RulesRequest_Type" json:"type,omitempty"`
+
+	RuleName  []string `protobuf:"bytes,2,rep,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleGroup []string `protobuf:"bytes,3,rep,name=rule_group,json=ruleGroup,proto3" json:"rule_group,omitempty"`
+	File      []string `protobuf:"bytes,4,rep,name=file,proto3" json:"file,omitempty"`
+
+	PartialResponseStrategy PartialResponseStrategy `protobuf:"varint,5,opt,name=partial_response_strategy,json=partialResponseStrategy,proto3,enum=thanos.PartialResponseStrategy" json:"partial_response_strategy,omitempty"`
+}
+
+func (m *RulesRequest) Reset()         { *m = RulesRequest{} }
+func (m *RulesRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RulesRequest) ProtoMessage()    {}
+
+func (m *RulesRequest) Equal(that interface{}) bool {
+	that1, ok := that.(*RulesRequest)
+	if !ok {
+		that2, ok := that.(RulesRequest)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if m.Type != that1.Type || m.PartialResponseStrategy != that1.PartialResponseStrategy {
+		return false
+	}
+	if len(m.RuleName) != len(that1.RuleName) || len(m.RuleGroup) != len(that1.RuleGroup) || len(m.File) != len(that1.File) {
+		return false
+	}
+	for i := range m.RuleName {
+		if m.RuleName[i] != that1.RuleName[i] {
+			return false
+		}
+	}
+	for i := range m.RuleGroup {
+		if m.RuleGroup[i] != that1.RuleGroup[i] {
+			return false
+		}
+	}
+	for i := range m.File {
+		if m.File[i] != that1.File[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *RulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	n += sizeVarintField(1, uint64(m.Type))
+	for _, s := range m.RuleName {
+		n += sizeStringField(2, s)
+	}
+	for _, s := range m.RuleGroup {
+		n += sizeStringField(3, s)
+	}
+	for _, s := range m.File {
+		n += sizeStringField(4, s)
+	}
+	n += sizeVarintField(5, uint64(m.PartialResponseStrategy))
+	return n
+}
+
+func (m *RulesRequest) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *RulesRequest) MarshalTo(dAtA []byte) ([]byte, error) {
+	dAtA = appendVarintField(dAtA, 1, uint64(m.Type))
+	for _, s := range m.RuleName {
+		dAtA = appendStringField(dAtA, 2, s)
+	}
+	for _, s := range m.RuleGroup {
+		dAtA = appendStringField(dAtA, 3, s)
+	}
+	for _, s := range m.File {
+		dAtA = appendStringField(dAtA, 4, s)
+	}
+	dAtA = appendVarintField(dAtA, 5, uint64(m.PartialResponseStrategy))
+	return dAtA, nil
+}
+
+func (m *RulesRequest) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.Type = RulesRequest_Type(v)
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.RuleName = append(m.RuleName, string(b))
+			i = end
+		case 3:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.RuleGroup = append(m.RuleGroup, string(b))
+			i = end
+		case 4:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.File = append(m.File, string(b))
+			i = end
+		case 5:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.PartialResponseStrategy = PartialResponseStrategy(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// RulesResponse is a single item in the Rules streaming RPC. Analogous to
+// storepb.SeriesResponse, it is a result/warning oneof so that partial
+// results can be surfaced without aborting the whole stream.
+type RulesResponse struct {
+	// Types that are valid to be assigned to Result:
+	//	*RulesResponse_Group
+	//	*RulesResponse_Warning
+	Result isRulesResponse_Result `protobuf_oneof:"result"`
+}
+
+func (m *RulesResponse) Reset()         { *m = RulesResponse{} }
+func (m *RulesResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RulesResponse) ProtoMessage()    {}
+
+type isRulesResponse_Result interface {
+	isRulesResponse_Result()
+}
+
+type RulesResponse_Group struct {
+	Group *RuleGroup `protobuf:"bytes,1,opt,name=group,proto3,oneof"`
+}
+type RulesResponse_Warning struct {
+	Warning string `protobuf:"bytes,2,opt,name=warning,proto3,oneof"`
+}
+
+func (*RulesResponse_Group) isRulesResponse_Result()   {}
+func (*RulesResponse_Warning) isRulesResponse_Result() {}
+
+func (m *RulesResponse) GetResult() isRulesResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *RulesResponse) GetGroup() *RuleGroup {
+	if x, ok := m.GetResult().(*RulesResponse_Group); ok {
+		return x.Group
+	}
+	return nil
+}
+
+func (m *RulesResponse) GetWarning() string {
+	if x, ok := m.GetResult().(*RulesResponse_Warning); ok {
+		return x.Warning
+	}
+	return ""
+}
+
+func (m *RulesResponse) Equal(that interface{}) bool {
+	that1, ok := that.(*RulesResponse)
+	if !ok {
+		that2, ok := that.(RulesResponse)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	switch r := m.Result.(type) {
+	case *RulesResponse_Group:
+		o, ok := that1.Result.(*RulesResponse_Group)
+		return ok && r.Group.Equal(o.Group)
+	case *RulesResponse_Warning:
+		o, ok := that1.Result.(*RulesResponse_Warning)
+		return ok && r.Warning == o.Warning
+	case nil:
+		return that1.Result == nil
+	default:
+		return false
+	}
+}
+
+func (m *RulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	switch r := m.Result.(type) {
+	case *RulesResponse_Group:
+		n += sizeMessageField(1, r.Group.Size())
+	case *RulesResponse_Warning:
+		n += sizeStringField(2, r.Warning)
+	}
+	return n
+}
+
+func (m *RulesResponse) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *RulesResponse) MarshalTo(dAtA []byte) ([]byte, error) {
+	switch r := m.Result.(type) {
+	case *RulesResponse_Group:
+		sub, err := r.Group.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 1, sub)
+	case *RulesResponse_Warning:
+		dAtA = appendStringField(dAtA, 2, r.Warning)
+	}
+	return dAtA, nil
+}
+
+func (m *RulesResponse) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			group := &RuleGroup{}
+			if err := group.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Result = &RulesResponse_Group{Group: group}
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Result = &RulesResponse_Warning{Warning: string(b)}
+			i = end
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// NewRuleGroupRulesResponse creates a new RulesResponse with a RuleGroup.
+func NewRuleGroupRulesResponse(rg *RuleGroup) *RulesResponse {
+	return &RulesResponse{
+		Result: &RulesResponse_Group{Group: rg},
+	}
+}
+
+// NewWarningRulesResponse creates a new RulesResponse with a warning.
+func NewWarningRulesResponse(warning error) *RulesResponse {
+	return &RulesResponse{
+		Result: &RulesResponse_Warning{Warning: warning.Error()},
+	}
+}
+
+type RuleGroups struct {
+	Groups []*RuleGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (m *RuleGroups) Reset()         { *m = RuleGroups{} }
+func (m *RuleGroups) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RuleGroups) ProtoMessage()    {}
+
+func (m *RuleGroups) GetGroups() []*RuleGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *RuleGroups) Equal(that interface{}) bool {
+	that1, ok := that.(*RuleGroups)
+	if !ok {
+		that2, ok := that.(RuleGroups)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if len(m.Groups) != len(that1.Groups) {
+		return false
+	}
+	for i := range m.Groups {
+		if !m.Groups[i].Equal(that1.Groups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *RuleGroups) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	for _, g := range m.Groups {
+		n += sizeMessageField(1, g.Size())
+	}
+	return n
+}
+
+func (m *RuleGroups) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *RuleGroups) MarshalTo(dAtA []byte) ([]byte, error) {
+	for _, g := range m.Groups {
+		sub, err := g.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 1, sub)
+	}
+	return dAtA, nil
+}
+
+func (m *RuleGroups) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			g := &RuleGroup{}
+			if err := g.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Groups = append(m.Groups, g)
+			i = end
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type RuleGroup struct {
+	Name                      string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	File                      string    `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Rules                     []*Rule   `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Interval                  float64   `protobuf:"fixed64,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	EvaluationDurationSeconds float64   `protobuf:"fixed64,5,opt,name=evaluation_duration_seconds,json=evaluationDurationSeconds,proto3" json:"evaluation_duration_seconds,omitempty"`
+	LastEvaluation            time.Time `protobuf:"bytes,6,opt,name=last_evaluation,json=lastEvaluation,proto3,stdtime" json:"last_evaluation"`
+
+	// Deprecated. Use PartialResponseStrategy instead.
+	DeprecatedPartialResponseStrategy PartialResponseStrategy `protobuf:"varint,7,opt,name=deprecated_partial_response_strategy,json=deprecatedPartialResponseStrategy,proto3,enum=thanos.PartialResponseStrategy" json:"deprecated_partial_response_strategy,omitempty"`
+	PartialResponseStrategy           PartialResponseStrategy `protobuf:"varint,8,opt,name=partial_response_strategy,json=partialResponseStrategy,proto3,enum=thanos.PartialResponseStrategy" json:"partial_response_strategy,omitempty"`
+}
+
+func (m *RuleGroup) Reset()         { *m = RuleGroup{} }
+func (m *RuleGroup) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RuleGroup) ProtoMessage()    {}
+
+func (m *RuleGroup) Equal(that interface{}) bool {
+	that1, ok := that.(*RuleGroup)
+	if !ok {
+		that2, ok := that.(RuleGroup)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if m.Name != that1.Name || m.File != that1.File || m.Interval != that1.Interval ||
+		m.EvaluationDurationSeconds != that1.EvaluationDurationSeconds ||
+		!timeEqual(m.LastEvaluation, that1.LastEvaluation) ||
+		m.DeprecatedPartialResponseStrategy != that1.DeprecatedPartialResponseStrategy ||
+		m.PartialResponseStrategy != that1.PartialResponseStrategy {
+		return false
+	}
+	if len(m.Rules) != len(that1.Rules) {
+		return false
+	}
+	for i := range m.Rules {
+		if !m.Rules[i].Equal(that1.Rules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *RuleGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	n += sizeStringField(1, m.Name)
+	n += sizeStringField(2, m.File)
+	for _, r := range m.Rules {
+		n += sizeMessageField(3, r.Size())
+	}
+	n += sizeFixed64Field(4, m.Interval)
+	n += sizeFixed64Field(5, m.EvaluationDurationSeconds)
+	n += sizeMessageFieldAlways(6, len(marshalStdTime(m.LastEvaluation)))
+	n += sizeVarintField(7, uint64(m.DeprecatedPartialResponseStrategy))
+	n += sizeVarintField(8, uint64(m.PartialResponseStrategy))
+	return n
+}
+
+func (m *RuleGroup) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *RuleGroup) MarshalTo(dAtA []byte) ([]byte, error) {
+	dAtA = appendStringField(dAtA, 1, m.Name)
+	dAtA = appendStringField(dAtA, 2, m.File)
+	for _, r := range m.Rules {
+		sub, err := r.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 3, sub)
+	}
+	dAtA = appendFixed64Field(dAtA, 4, m.Interval)
+	dAtA = appendFixed64Field(dAtA, 5, m.EvaluationDurationSeconds)
+	dAtA = appendMessageFieldAlways(dAtA, 6, marshalStdTime(m.LastEvaluation))
+	dAtA = appendVarintField(dAtA, 7, uint64(m.DeprecatedPartialResponseStrategy))
+	dAtA = appendVarintField(dAtA, 8, uint64(m.PartialResponseStrategy))
+	return dAtA, nil
+}
+
+func (m *RuleGroup) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Name = string(b)
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.File = string(b)
+			i = end
+		case 3:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			r := &Rule{}
+			if err := r.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Rules = append(m.Rules, r)
+			i = end
+		case 4:
+			v, err := readFixed64(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Interval = v
+			i += 8
+		case 5:
+			v, err := readFixed64(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.EvaluationDurationSeconds = v
+			i += 8
+		case 6:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			t, err := unmarshalStdTime(b)
+			if err != nil {
+				return err
+			}
+			m.LastEvaluation = t
+			i = end
+		case 7:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.DeprecatedPartialResponseStrategy = PartialResponseStrategy(v)
+		case 8:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.PartialResponseStrategy = PartialResponseStrategy(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// Rule is a generic rule, it can be either a recording or alerting rule.
+type Rule struct {
+	// Types that are valid to be assigned to Result:
+	//	*Rule_Alert
+	//	*Rule_Recording
+	Result isRule_Result `protobuf_oneof:"result"`
+}
+
+func (m *Rule) Reset()         { *m = Rule{} }
+func (m *Rule) String() string { return fmt.Sprintf("%+v", *m) }
+func (*Rule) ProtoMessage()    {}
+
+type isRule_Result interface {
+	isRule_Result()
+}
+
+type Rule_Alert struct {
+	Alert *Alert `protobuf:"bytes,1,opt,name=alert,proto3,oneof"`
+}
+type Rule_Recording struct {
+	Recording *RecordingRule `protobuf:"bytes,2,opt,name=recording,proto3,oneof"`
+}
+
+func (*Rule_Alert) isRule_Result()     {}
+func (*Rule_Recording) isRule_Result() {}
+
+func (m *Rule) GetResult() isRule_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *Rule) GetAlert() *Alert {
+	if x, ok := m.GetResult().(*Rule_Alert); ok {
+		return x.Alert
+	}
+	return nil
+}
+
+func (m *Rule) GetRecording() *RecordingRule {
+	if x, ok := m.GetResult().(*Rule_Recording); ok {
+		return x.Recording
+	}
+	return nil
+}
+
+func (m *Rule) Equal(that interface{}) bool {
+	that1, ok := that.(*Rule)
+	if !ok {
+		that2, ok := that.(Rule)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	switch r := m.Result.(type) {
+	case *Rule_Alert:
+		o, ok := that1.Result.(*Rule_Alert)
+		return ok && r.Alert.Equal(o.Alert)
+	case *Rule_Recording:
+		o, ok := that1.Result.(*Rule_Recording)
+		return ok && r.Recording.Equal(o.Recording)
+	case nil:
+		return that1.Result == nil
+	default:
+		return false
+	}
+}
+
+func (m *Rule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	switch r := m.Result.(type) {
+	case *Rule_Alert:
+		n += sizeMessageField(1, r.Alert.Size())
+	case *Rule_Recording:
+		n += sizeMessageField(2, r.Recording.Size())
+	}
+	return n
+}
+
+func (m *Rule) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *Rule) MarshalTo(dAtA []byte) ([]byte, error) {
+	switch r := m.Result.(type) {
+	case *Rule_Alert:
+		sub, err := r.Alert.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 1, sub)
+	case *Rule_Recording:
+		sub, err := r.Recording.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 2, sub)
+	}
+	return dAtA, nil
+}
+
+func (m *Rule) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			a := &Alert{}
+			if err := a.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Result = &Rule_Alert{Alert: a}
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			r := &RecordingRule{}
+			if err := r.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Result = &Rule_Recording{Recording: r}
+			i = end
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type RecordingRule struct {
+	Name                      string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query                     string      `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Labels                    PromLabels  `protobuf:"bytes,3,opt,name=labels,proto3" json:"labels"`
+	LastError                 string      `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Health                    string      `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
+	LastEvaluation            time.Time   `protobuf:"bytes,6,opt,name=last_evaluation,json=lastEvaluation,proto3,stdtime" json:"last_evaluation"`
+	EvaluationDurationSeconds float64     `protobuf:"fixed64,7,opt,name=evaluation_duration_seconds,json=evaluationDurationSeconds,proto3" json:"evaluation_duration_seconds,omitempty"`
+}
+
+func (m *RecordingRule) Reset()         { *m = RecordingRule{} }
+func (m *RecordingRule) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RecordingRule) ProtoMessage()    {}
+
+func (m *RecordingRule) Equal(that interface{}) bool {
+	that1, ok := that.(*RecordingRule)
+	if !ok {
+		that2, ok := that.(RecordingRule)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	return m.Name == that1.Name && m.Query == that1.Query && m.Labels.Equal(that1.Labels) &&
+		m.LastError == that1.LastError && m.Health == that1.Health &&
+		timeEqual(m.LastEvaluation, that1.LastEvaluation) &&
+		m.EvaluationDurationSeconds == that1.EvaluationDurationSeconds
+}
+
+func (m *RecordingRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	n += sizeStringField(1, m.Name)
+	n += sizeStringField(2, m.Query)
+	n += sizeMessageFieldAlways(3, m.Labels.Size())
+	n += sizeStringField(4, m.LastError)
+	n += sizeStringField(5, m.Health)
+	n += sizeMessageFieldAlways(6, len(marshalStdTime(m.LastEvaluation)))
+	n += sizeFixed64Field(7, m.EvaluationDurationSeconds)
+	return n
+}
+
+func (m *RecordingRule) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *RecordingRule) MarshalTo(dAtA []byte) ([]byte, error) {
+	dAtA = appendStringField(dAtA, 1, m.Name)
+	dAtA = appendStringField(dAtA, 2, m.Query)
+	sub, err := m.Labels.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	dAtA = appendMessageFieldAlways(dAtA, 3, sub)
+	dAtA = appendStringField(dAtA, 4, m.LastError)
+	dAtA = appendStringField(dAtA, 5, m.Health)
+	dAtA = appendMessageFieldAlways(dAtA, 6, marshalStdTime(m.LastEvaluation))
+	dAtA = appendFixed64Field(dAtA, 7, m.EvaluationDurationSeconds)
+	return dAtA, nil
+}
+
+func (m *RecordingRule) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Name = string(b)
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Query = string(b)
+			i = end
+		case 3:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Labels = PromLabels{}
+			if err := m.Labels.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 4:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.LastError = string(b)
+			i = end
+		case 5:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Health = string(b)
+			i = end
+		case 6:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			t, err := unmarshalStdTime(b)
+			if err != nil {
+				return err
+			}
+			m.LastEvaluation = t
+			i = end
+		case 7:
+			v, err := readFixed64(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.EvaluationDurationSeconds = v
+			i += 8
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type Alert struct {
+	Name                      string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query                     string           `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	DurationSeconds           float64          `protobuf:"fixed64,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Labels                    PromLabels       `protobuf:"bytes,4,opt,name=labels,proto3" json:"labels"`
+	Annotations               PromLabels       `protobuf:"bytes,5,opt,name=annotations,proto3" json:"annotations"`
+	Alerts                    []*AlertInstance `protobuf:"bytes,6,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	Health                    string           `protobuf:"bytes,7,opt,name=health,proto3" json:"health,omitempty"`
+	LastError                 string           `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastEvaluation            time.Time        `protobuf:"bytes,9,opt,name=last_evaluation,json=lastEvaluation,proto3,stdtime" json:"last_evaluation"`
+	EvaluationDurationSeconds float64          `protobuf:"fixed64,10,opt,name=evaluation_duration_seconds,json=evaluationDurationSeconds,proto3" json:"evaluation_duration_seconds,omitempty"`
+	State                     AlertState       `protobuf:"varint,11,opt,name=state,proto3,enum=thanos.AlertState" json:"state,omitempty"`
+}
+
+func (m *Alert) Reset()         { *m = Alert{} }
+func (m *Alert) String() string { return fmt.Sprintf("%+v", *m) }
+func (*Alert) ProtoMessage()    {}
+
+func (m *Alert) Equal(that interface{}) bool {
+	that1, ok := that.(*Alert)
+	if !ok {
+		that2, ok := that.(Alert)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if m.Name != that1.Name || m.Query != that1.Query || m.DurationSeconds != that1.DurationSeconds ||
+		!m.Labels.Equal(that1.Labels) || !m.Annotations.Equal(that1.Annotations) ||
+		m.Health != that1.Health || m.LastError != that1.LastError ||
+		!timeEqual(m.LastEvaluation, that1.LastEvaluation) ||
+		m.EvaluationDurationSeconds != that1.EvaluationDurationSeconds || m.State != that1.State {
+		return false
+	}
+	if len(m.Alerts) != len(that1.Alerts) {
+		return false
+	}
+	for i := range m.Alerts {
+		if !m.Alerts[i].Equal(that1.Alerts[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Alert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	n += sizeStringField(1, m.Name)
+	n += sizeStringField(2, m.Query)
+	n += sizeFixed64Field(3, m.DurationSeconds)
+	n += sizeMessageFieldAlways(4, m.Labels.Size())
+	n += sizeMessageFieldAlways(5, m.Annotations.Size())
+	for _, a := range m.Alerts {
+		n += sizeMessageField(6, a.Size())
+	}
+	n += sizeStringField(7, m.Health)
+	n += sizeStringField(8, m.LastError)
+	n += sizeMessageFieldAlways(9, len(marshalStdTime(m.LastEvaluation)))
+	n += sizeFixed64Field(10, m.EvaluationDurationSeconds)
+	n += sizeVarintField(11, uint64(m.State))
+	return n
+}
+
+func (m *Alert) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *Alert) MarshalTo(dAtA []byte) ([]byte, error) {
+	dAtA = appendStringField(dAtA, 1, m.Name)
+	dAtA = appendStringField(dAtA, 2, m.Query)
+	dAtA = appendFixed64Field(dAtA, 3, m.DurationSeconds)
+	labelsSub, err := m.Labels.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	dAtA = appendMessageFieldAlways(dAtA, 4, labelsSub)
+	annotationsSub, err := m.Annotations.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	dAtA = appendMessageFieldAlways(dAtA, 5, annotationsSub)
+	for _, a := range m.Alerts {
+		sub, err := a.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = appendMessageField(dAtA, 6, sub)
+	}
+	dAtA = appendStringField(dAtA, 7, m.Health)
+	dAtA = appendStringField(dAtA, 8, m.LastError)
+	dAtA = appendMessageFieldAlways(dAtA, 9, marshalStdTime(m.LastEvaluation))
+	dAtA = appendFixed64Field(dAtA, 10, m.EvaluationDurationSeconds)
+	dAtA = appendVarintField(dAtA, 11, uint64(m.State))
+	return dAtA, nil
+}
+
+func (m *Alert) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Name = string(b)
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Query = string(b)
+			i = end
+		case 3:
+			v, err := readFixed64(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.DurationSeconds = v
+			i += 8
+		case 4:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Labels = PromLabels{}
+			if err := m.Labels.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 5:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Annotations = PromLabels{}
+			if err := m.Annotations.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 6:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			a := &AlertInstance{}
+			if err := a.Unmarshal(b); err != nil {
+				return err
+			}
+			m.Alerts = append(m.Alerts, a)
+			i = end
+		case 7:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Health = string(b)
+			i = end
+		case 8:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.LastError = string(b)
+			i = end
+		case 9:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			t, err := unmarshalStdTime(b)
+			if err != nil {
+				return err
+			}
+			m.LastEvaluation = t
+			i = end
+		case 10:
+			v, err := readFixed64(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.EvaluationDurationSeconds = v
+			i += 8
+		case 11:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.State = AlertState(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+type AlertInstance struct {
+	Labels                  PromLabels              `protobuf:"bytes,1,opt,name=labels,proto3" json:"labels"`
+	Annotations             PromLabels              `protobuf:"bytes,2,opt,name=annotations,proto3" json:"annotations"`
+	State                   AlertState              `protobuf:"varint,3,opt,name=state,proto3,enum=thanos.AlertState" json:"state,omitempty"`
+	ActiveAt                *time.Time              `protobuf:"bytes,4,opt,name=active_at,json=activeAt,proto3,stdtime" json:"active_at,omitempty"`
+	Value                   string                  `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	PartialResponseStrategy PartialResponseStrategy `protobuf:"varint,6,opt,name=partial_response_strategy,json=partialResponseStrategy,proto3,enum=thanos.PartialResponseStrategy" json:"partial_response_strategy,omitempty"`
+}
+
+func (m *AlertInstance) Reset()         { *m = AlertInstance{} }
+func (m *AlertInstance) String() string { return fmt.Sprintf("%+v", *m) }
+func (*AlertInstance) ProtoMessage()    {}
+
+func (m *AlertInstance) Equal(that interface{}) bool {
+	that1, ok := that.(*AlertInstance)
+	if !ok {
+		that2, ok := that.(AlertInstance)
+		if !ok {
+			return false
+		}
+		that1 = &that2
+	}
+	if that1 == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+	if !m.Labels.Equal(that1.Labels) || !m.Annotations.Equal(that1.Annotations) ||
+		m.State != that1.State || m.Value != that1.Value ||
+		m.PartialResponseStrategy != that1.PartialResponseStrategy {
+		return false
+	}
+	if (m.ActiveAt == nil) != (that1.ActiveAt == nil) {
+		return false
+	}
+	if m.ActiveAt != nil && !timeEqual(*m.ActiveAt, *that1.ActiveAt) {
+		return false
+	}
+	return true
+}
+
+func (m *AlertInstance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	n += sizeMessageFieldAlways(1, m.Labels.Size())
+	n += sizeMessageFieldAlways(2, m.Annotations.Size())
+	n += sizeVarintField(3, uint64(m.State))
+	if m.ActiveAt != nil {
+		n += sizeMessageField(4, len(marshalStdTime(*m.ActiveAt)))
+	}
+	n += sizeStringField(5, m.Value)
+	n += sizeVarintField(6, uint64(m.PartialResponseStrategy))
+	return n
+}
+
+func (m *AlertInstance) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+	return m.MarshalTo(dAtA)
+}
+
+func (m *AlertInstance) MarshalTo(dAtA []byte) ([]byte, error) {
+	labelsSub, err := m.Labels.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	dAtA = appendMessageFieldAlways(dAtA, 1, labelsSub)
+	annotationsSub, err := m.Annotations.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	dAtA = appendMessageFieldAlways(dAtA, 2, annotationsSub)
+	dAtA = appendVarintField(dAtA, 3, uint64(m.State))
+	if m.ActiveAt != nil {
+		dAtA = appendMessageField(dAtA, 4, marshalStdTime(*m.ActiveAt))
+	}
+	dAtA = appendStringField(dAtA, 5, m.Value)
+	dAtA = appendVarintField(dAtA, 6, uint64(m.PartialResponseStrategy))
+	return dAtA, nil
+}
+
+func (m *AlertInstance) Unmarshal(dAtA []byte) error {
+	i := 0
+	l := len(dAtA)
+	for i < l {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Labels = PromLabels{}
+			if err := m.Labels.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 2:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Annotations = PromLabels{}
+			if err := m.Annotations.Unmarshal(b); err != nil {
+				return err
+			}
+			i = end
+		case 3:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.State = AlertState(v)
+		case 4:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			t, err := unmarshalStdTime(b)
+			if err != nil {
+				return err
+			}
+			m.ActiveAt = &t
+			i = end
+		case 5:
+			b, end, err := readLengthDelimited(dAtA, i)
+			if err != nil {
+				return err
+			}
+			m.Value = string(b)
+			i = end
+		case 6:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return err
+			}
+			i += n
+			m.PartialResponseStrategy = PartialResponseStrategy(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return err
+			}
+			i = ni
+		}
+	}
+	return nil
+}
+
+// RulesClient is the client API for the Rules service.
+type RulesClient interface {
+	Rules(ctx context.Context, in *RulesRequest, opts ...grpc.CallOption) (Rules_RulesClient, error)
+}
+
+type rulesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRulesClient(cc *grpc.ClientConn) RulesClient {
+	return &rulesClient{cc}
+}
+
+func (c *rulesClient) Rules(ctx context.Context, in *RulesRequest, opts ...grpc.CallOption) (Rules_RulesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Rules_serviceDesc.Streams[0], "/thanos.Rules/Rules", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rulesRulesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Rules_RulesClient interface {
+	Recv() (*RulesResponse, error)
+	grpc.ClientStream
+}
+
+type rulesRulesClient struct {
+	grpc.ClientStream
+}
+
+func (x *rulesRulesClient) Recv() (*RulesResponse, error) {
+	m := new(RulesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RulesServer is the server API for the Rules service.
+type RulesServer interface {
+	Rules(*RulesRequest, Rules_RulesServer) error
+}
+
+// UnimplementedRulesServer can be embedded to have forward compatible implementations.
+type UnimplementedRulesServer struct{}
+
+func (*UnimplementedRulesServer) Rules(*RulesRequest, Rules_RulesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Rules not implemented")
+}
+
+func RegisterRulesServer(s *grpc.Server, srv RulesServer) {
+	s.RegisterService(&_Rules_serviceDesc, srv)
+}
+
+func _Rules_Rules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RulesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RulesServer).Rules(m, &rulesRulesServer{stream})
+}
+
+type Rules_RulesServer interface {
+	Send(*RulesResponse) error
+	grpc.ServerStream
+}
+
+type rulesRulesServer struct {
+	grpc.ServerStream
+}
+
+func (x *rulesRulesServer) Send(m *RulesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Rules_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "thanos.Rules",
+	HandlerType: (*RulesServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Rules",
+			Handler:       _Rules_Rules_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rules.proto",
+}
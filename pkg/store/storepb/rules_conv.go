@@ -0,0 +1,126 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+import (
+	"strconv"
+
+	"github.com/prometheus/prometheus/rules"
+)
+
+// NewRuleGroups is the in-process counterpart of pkg/rules's Proxy: it lets a
+// sidecar or Ruler that embeds a Prometheus rule manager serve the Rules gRPC
+// API directly out of that manager's groups, rather than scraping its own
+// /api/v1/rules endpoint. EvaluationTime, LastEvaluation, Health, LastError
+// and, for alerting rules, State are carried over so that a Thanos-Query
+// federating across sidecars sees the same values Prometheus itself would
+// report.
+func NewRuleGroups(groups []*rules.Group, strategy PartialResponseStrategy) *RuleGroups {
+	ret := &RuleGroups{Groups: make([]*RuleGroup, 0, len(groups))}
+	for _, g := range groups {
+		ret.Groups = append(ret.Groups, newRuleGroup(g, strategy))
+	}
+	return ret
+}
+
+func newRuleGroup(g *rules.Group, strategy PartialResponseStrategy) *RuleGroup {
+	rg := &RuleGroup{
+		Name:                              g.Name(),
+		File:                              g.File(),
+		Interval:                          g.Interval().Seconds(),
+		EvaluationDurationSeconds:         g.GetEvaluationTime().Seconds(),
+		LastEvaluation:                    g.GetLastEvaluation(),
+		DeprecatedPartialResponseStrategy: strategy,
+		PartialResponseStrategy:           strategy,
+	}
+
+	grules := g.Rules()
+	if len(grules) == 0 {
+		return rg
+	}
+
+	rg.Rules = make([]*Rule, 0, len(grules))
+	for _, r := range grules {
+		rg.Rules = append(rg.Rules, newRule(r))
+	}
+	return rg
+}
+
+func newRule(r rules.Rule) *Rule {
+	switch rule := r.(type) {
+	case *rules.AlertingRule:
+		return &Rule{Result: &Rule_Alert{Alert: newAlertingRule(rule)}}
+	case *rules.RecordingRule:
+		return &Rule{Result: &Rule_Recording{Recording: &RecordingRule{
+			Name:                      rule.Name(),
+			Query:                     rule.Query().String(),
+			Labels:                    *LabelsToPromLabels(rule.Labels()),
+			Health:                    string(rule.Health()),
+			LastError:                 lastErrorString(rule.LastError()),
+			LastEvaluation:            rule.GetEvaluationTimestamp(),
+			EvaluationDurationSeconds: rule.GetEvaluationDuration().Seconds(),
+		}}}
+	default:
+		// Unknown rule type (e.g. a Prometheus version introduced a new
+		// kind of rule); surface just what the generic Rule interface
+		// gives us as a recording rule so it is not silently dropped.
+		return &Rule{Result: &Rule_Recording{Recording: &RecordingRule{
+			Name:                      r.Name(),
+			Labels:                    *LabelsToPromLabels(r.Labels()),
+			Health:                    string(r.Health()),
+			LastError:                 lastErrorString(r.LastError()),
+			LastEvaluation:            r.GetEvaluationTimestamp(),
+			EvaluationDurationSeconds: r.GetEvaluationDuration().Seconds(),
+		}}}
+	}
+}
+
+func newAlertingRule(rule *rules.AlertingRule) *Alert {
+	active := rule.ActiveAlerts()
+	var alerts []*AlertInstance
+	if len(active) > 0 {
+		alerts = make([]*AlertInstance, 0, len(active))
+		for _, a := range active {
+			alerts = append(alerts, &AlertInstance{
+				Labels:      *LabelsToPromLabels(a.Labels),
+				Annotations: *LabelsToPromLabels(a.Annotations),
+				State:       alertStateToProto(a.State),
+				ActiveAt:    &a.ActiveAt,
+				Value:       strconv.FormatFloat(a.Value, 'e', -1, 64),
+			})
+		}
+	}
+
+	return &Alert{
+		Name:                      rule.Name(),
+		Query:                     rule.Query().String(),
+		DurationSeconds:           rule.HoldDuration().Seconds(),
+		Labels:                    *LabelsToPromLabels(rule.Labels()),
+		Annotations:               *LabelsToPromLabels(rule.Annotations()),
+		Alerts:                    alerts,
+		Health:                    string(rule.Health()),
+		LastError:                 lastErrorString(rule.LastError()),
+		LastEvaluation:            rule.GetEvaluationTimestamp(),
+		EvaluationDurationSeconds: rule.GetEvaluationDuration().Seconds(),
+		State:                     alertStateToProto(rule.State()),
+	}
+}
+
+func alertStateToProto(s rules.AlertState) AlertState {
+	switch s {
+	case rules.StatePending:
+		return AlertState_PENDING
+	case rules.StateFiring:
+		return AlertState_FIRING
+	default:
+		return AlertState_INACTIVE
+	}
+}
+
+func lastErrorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,99 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/rules"
+
+	"github.com/thanos-io/thanos/pkg/testutil"
+)
+
+func TestAlertStateToProto(t *testing.T) {
+	for _, tcase := range []struct {
+		state    rules.AlertState
+		expected AlertState
+	}{
+		{state: rules.StateInactive, expected: AlertState_INACTIVE},
+		{state: rules.StatePending, expected: AlertState_PENDING},
+		{state: rules.StateFiring, expected: AlertState_FIRING},
+	} {
+		testutil.Equals(t, tcase.expected, alertStateToProto(tcase.state))
+	}
+}
+
+func TestNewRuleGroups(t *testing.T) {
+	recordingExpr, err := parser.ParseExpr("job:requests:rate5m")
+	testutil.Ok(t, err)
+	recordingRule := rules.NewRecordingRule(
+		"job:requests:rate5m",
+		recordingExpr,
+		labels.Labels{{Name: "job", Value: "api"}},
+	)
+
+	alertingExpr, err := parser.ParseExpr("up == 0")
+	testutil.Ok(t, err)
+	alertingRule := rules.NewAlertingRule(
+		"InstanceDown",
+		alertingExpr,
+		5*time.Minute,
+		labels.Labels{{Name: "severity", Value: "page"}},
+		labels.Labels{{Name: "summary", Value: "instance down"}},
+		"",
+		true,
+		log.NewNopLogger(),
+	)
+
+	group := rules.NewGroup(rules.GroupOptions{
+		Name:     "example",
+		File:     "alerts.yml",
+		Interval: 30 * time.Second,
+		Rules:    []rules.Rule{recordingRule, alertingRule},
+		Opts:     &rules.ManagerOptions{Context: context.Background(), Logger: log.NewNopLogger()},
+	})
+
+	out := NewRuleGroups([]*rules.Group{group}, PartialResponseStrategy_ABORT)
+	testutil.Equals(t, 1, len(out.Groups))
+
+	rg := out.Groups[0]
+	testutil.Equals(t, "example", rg.Name)
+	testutil.Equals(t, "alerts.yml", rg.File)
+	testutil.Equals(t, 30.0, rg.Interval)
+	testutil.Equals(t, PartialResponseStrategy_ABORT, rg.PartialResponseStrategy)
+	testutil.Equals(t, PartialResponseStrategy_ABORT, rg.DeprecatedPartialResponseStrategy)
+	testutil.Equals(t, 2, len(rg.Rules))
+
+	recordingOut := rg.Rules[0].GetRecording()
+	testutil.Assert(t, recordingOut != nil, "expected a recording rule")
+	testutil.Equals(t, "job:requests:rate5m", recordingOut.Name)
+	testutil.Equals(t, "job:requests:rate5m", recordingOut.Query)
+	testutil.Equals(t, PromLabels{Labels: []Label{{Name: "job", Value: "api"}}}, recordingOut.Labels)
+
+	alertOut := rg.Rules[1].GetAlert()
+	testutil.Assert(t, alertOut != nil, "expected an alerting rule")
+	testutil.Equals(t, "InstanceDown", alertOut.Name)
+	testutil.Equals(t, "up == 0", alertOut.Query)
+	testutil.Equals(t, 300.0, alertOut.DurationSeconds)
+	testutil.Equals(t, AlertState_INACTIVE, alertOut.State)
+	testutil.Equals(t, 0, len(alertOut.Alerts))
+}
+
+func TestNewRuleGroupsEmptyGroup(t *testing.T) {
+	group := rules.NewGroup(rules.GroupOptions{
+		Name:     "empty",
+		File:     "empty.yml",
+		Interval: time.Minute,
+		Opts:     &rules.ManagerOptions{Context: context.Background(), Logger: log.NewNopLogger()},
+	})
+
+	out := NewRuleGroups([]*rules.Group{group}, PartialResponseStrategy_WARN)
+	testutil.Equals(t, 1, len(out.Groups))
+	testutil.Equals(t, 0, len(out.Groups[0].Rules))
+}
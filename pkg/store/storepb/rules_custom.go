@@ -0,0 +1,316 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/rules"
+)
+
+const (
+	RuleRecordingType = "recording"
+	RuleAlertingType  = "alerting"
+)
+
+// UnmarshalJSON unmarshals RuleGroups from the same flat JSON document
+// (`{"groups": [...]}`) that Prometheus's /api/v1/rules endpoint serves,
+// so that Thanos components can decode a downstream Prometheus's rule
+// discovery response directly into our wire format.
+func (m *RuleGroups) UnmarshalJSON(entry []byte) error {
+	decoded := struct {
+		Groups []json.RawMessage `json:"groups"`
+	}{}
+	if err := json.Unmarshal(entry, &decoded); err != nil {
+		return err
+	}
+
+	for _, g := range decoded.Groups {
+		group := &RuleGroup{}
+		if err := group.UnmarshalJSON(g); err != nil {
+			return err
+		}
+		m.Groups = append(m.Groups, group)
+	}
+	return nil
+}
+
+// MarshalJSON marshals RuleGroups back into the same JSON shape it was
+// decoded from.
+func (m *RuleGroups) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Groups []*RuleGroup `json:"groups"`
+	}{Groups: m.Groups})
+}
+
+type ruleGroupJSON struct {
+	Name           string            `json:"name"`
+	File           string            `json:"file"`
+	Rules          []json.RawMessage `json:"rules"`
+	Interval       float64           `json:"interval"`
+	EvaluationTime float64           `json:"evaluationTime"`
+	LastEvaluation time.Time         `json:"lastEvaluation"`
+
+	DeprecatedPartialResponseStrategy string `json:"partial_response_strategy"`
+	PartialResponseStrategy           string `json:"partialResponseStrategy"`
+}
+
+func (m *RuleGroup) UnmarshalJSON(b []byte) error {
+	var raw ruleGroupJSON
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	deprecatedStrategy, err := parsePartialResponseStrategy(raw.DeprecatedPartialResponseStrategy)
+	if err != nil {
+		return err
+	}
+	strategy, err := parsePartialResponseStrategy(raw.PartialResponseStrategy)
+	if err != nil {
+		return err
+	}
+
+	var decodedRules []*Rule
+	if len(raw.Rules) > 0 {
+		decodedRules = make([]*Rule, 0, len(raw.Rules))
+		for _, r := range raw.Rules {
+			rule, err := unmarshalRule(r)
+			if err != nil {
+				return err
+			}
+			decodedRules = append(decodedRules, rule)
+		}
+	}
+
+	m.Name = raw.Name
+	m.File = raw.File
+	m.Rules = decodedRules
+	m.Interval = raw.Interval
+	m.EvaluationDurationSeconds = raw.EvaluationTime
+	m.LastEvaluation = raw.LastEvaluation
+	m.DeprecatedPartialResponseStrategy = deprecatedStrategy
+	m.PartialResponseStrategy = strategy
+	return nil
+}
+
+// ruleGroupMarshalJSON mirrors ruleGroupJSON but keeps Rules typed as
+// []*Rule (rather than json.RawMessage) so json.Marshal dispatches to
+// Rule.MarshalJSON for each entry and retains its concrete recording/
+// alerting shape.
+type ruleGroupMarshalJSON struct {
+	Name           string    `json:"name"`
+	File           string    `json:"file"`
+	Rules          []*Rule   `json:"rules"`
+	Interval       float64   `json:"interval"`
+	EvaluationTime float64   `json:"evaluationTime"`
+	LastEvaluation time.Time `json:"lastEvaluation"`
+
+	DeprecatedPartialResponseStrategy string `json:"partial_response_strategy"`
+	PartialResponseStrategy           string `json:"partialResponseStrategy"`
+}
+
+func (m *RuleGroup) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ruleGroupMarshalJSON{
+		Name:                              m.Name,
+		File:                              m.File,
+		Rules:                             m.Rules,
+		Interval:                          m.Interval,
+		EvaluationTime:                    m.EvaluationDurationSeconds,
+		LastEvaluation:                    m.LastEvaluation,
+		DeprecatedPartialResponseStrategy: m.DeprecatedPartialResponseStrategy.String(),
+		PartialResponseStrategy:           m.PartialResponseStrategy.String(),
+	})
+}
+
+type recordingRuleJSON struct {
+	Name           string           `json:"name"`
+	Query          string           `json:"query"`
+	Labels         labels.Labels    `json:"labels,omitempty"`
+	Health         rules.RuleHealth `json:"health"`
+	LastError      string           `json:"lastError,omitempty"`
+	EvaluationTime float64          `json:"evaluationTime"`
+	LastEvaluation time.Time        `json:"lastEvaluation"`
+	Type           string           `json:"type"`
+}
+
+type alertJSON struct {
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	State       string        `json:"state"`
+	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
+	Value       string        `json:"value"`
+
+	PartialResponseStrategy string `json:"partialResponseStrategy"`
+}
+
+type alertingRuleJSON struct {
+	// State can be "pending", "firing", "inactive".
+	State          string           `json:"state"`
+	Name           string           `json:"name"`
+	Query          string           `json:"query"`
+	Duration       float64          `json:"duration"`
+	Labels         labels.Labels    `json:"labels"`
+	Annotations    labels.Labels    `json:"annotations"`
+	Alerts         []*alertJSON     `json:"alerts"`
+	Health         rules.RuleHealth `json:"health"`
+	LastError      string           `json:"lastError,omitempty"`
+	EvaluationTime float64          `json:"evaluationTime"`
+	LastEvaluation time.Time        `json:"lastEvaluation"`
+	Type           string           `json:"type"`
+}
+
+// unmarshalRule decodes a single raw rule entry, dispatching on its "type"
+// field the same way Prometheus's own rule discovery JSON does.
+func unmarshalRule(raw json.RawMessage) (*Rule, error) {
+	var typeDetect struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &typeDetect); err != nil {
+		return nil, err
+	}
+
+	switch typeDetect.Type {
+	case "":
+		return nil, errors.Errorf("rule: no type field provided: %s", raw)
+	case RuleRecordingType:
+		var rr recordingRuleJSON
+		if err := json.Unmarshal(raw, &rr); err != nil {
+			return nil, errors.Wrapf(err, "rule: recording rule unmarshal: %s", raw)
+		}
+		return &Rule{Result: &Rule_Recording{Recording: &RecordingRule{
+			Name:                      rr.Name,
+			Query:                     rr.Query,
+			Labels:                    *LabelsToPromLabels(rr.Labels),
+			LastError:                 rr.LastError,
+			Health:                    string(rr.Health),
+			LastEvaluation:            rr.LastEvaluation,
+			EvaluationDurationSeconds: rr.EvaluationTime,
+		}}}, nil
+	case RuleAlertingType:
+		var ar alertingRuleJSON
+		if err := json.Unmarshal(raw, &ar); err != nil {
+			return nil, errors.Wrapf(err, "rule: alerting rule unmarshal: %s", raw)
+		}
+
+		state, err := parseAlertState(ar.State)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule: alerting rule unmarshal: %s", raw)
+		}
+
+		var alerts []*AlertInstance
+		if len(ar.Alerts) > 0 {
+			alerts = make([]*AlertInstance, 0, len(ar.Alerts))
+			for _, a := range ar.Alerts {
+				alertState, err := parseAlertState(a.State)
+				if err != nil {
+					return nil, errors.Wrapf(err, "rule: alerting rule unmarshal: %s", raw)
+				}
+				prs, err := parsePartialResponseStrategy(a.PartialResponseStrategy)
+				if err != nil {
+					return nil, errors.Wrapf(err, "rule: alerting rule unmarshal: %s", raw)
+				}
+				alerts = append(alerts, &AlertInstance{
+					Labels:                  *LabelsToPromLabels(a.Labels),
+					Annotations:             *LabelsToPromLabels(a.Annotations),
+					State:                   alertState,
+					ActiveAt:                a.ActiveAt,
+					Value:                   a.Value,
+					PartialResponseStrategy: prs,
+				})
+			}
+		}
+
+		return &Rule{Result: &Rule_Alert{Alert: &Alert{
+			Name:                      ar.Name,
+			Query:                     ar.Query,
+			DurationSeconds:           ar.Duration,
+			Labels:                    *LabelsToPromLabels(ar.Labels),
+			Annotations:               *LabelsToPromLabels(ar.Annotations),
+			Alerts:                    alerts,
+			Health:                    string(ar.Health),
+			LastError:                 ar.LastError,
+			LastEvaluation:            ar.LastEvaluation,
+			EvaluationDurationSeconds: ar.EvaluationTime,
+			State:                     state,
+		}}}, nil
+	default:
+		return nil, errors.Errorf("rule: unknown type field provided %s; %s", typeDetect.Type, raw)
+	}
+}
+
+// MarshalJSON marshals a Rule back into the same shape Prometheus uses for
+// an individual alerting or recording rule, dispatching on the oneof result.
+func (m *Rule) MarshalJSON() ([]byte, error) {
+	if r := m.GetRecording(); r != nil {
+		return json.Marshal(recordingRuleJSON{
+			Name:           r.Name,
+			Query:          r.Query,
+			Labels:         PromLabelsToLabels(&r.Labels),
+			Health:         rules.RuleHealth(r.Health),
+			LastError:      r.LastError,
+			EvaluationTime: r.EvaluationDurationSeconds,
+			LastEvaluation: r.LastEvaluation,
+			Type:           RuleRecordingType,
+		})
+	}
+	if a := m.GetAlert(); a != nil {
+		var alerts []*alertJSON
+		if len(a.Alerts) > 0 {
+			alerts = make([]*alertJSON, 0, len(a.Alerts))
+			for _, ai := range a.Alerts {
+				alerts = append(alerts, &alertJSON{
+					Labels:                  PromLabelsToLabels(&ai.Labels),
+					Annotations:             PromLabelsToLabels(&ai.Annotations),
+					State:                   ai.State.String(),
+					ActiveAt:                ai.ActiveAt,
+					Value:                   ai.Value,
+					PartialResponseStrategy: ai.PartialResponseStrategy.String(),
+				})
+			}
+		}
+		return json.Marshal(alertingRuleJSON{
+			State:          a.State.String(),
+			Name:           a.Name,
+			Query:          a.Query,
+			Duration:       a.DurationSeconds,
+			Labels:         PromLabelsToLabels(&a.Labels),
+			Annotations:    PromLabelsToLabels(&a.Annotations),
+			Alerts:         alerts,
+			Health:         rules.RuleHealth(a.Health),
+			LastError:      a.LastError,
+			EvaluationTime: a.EvaluationDurationSeconds,
+			LastEvaluation: a.LastEvaluation,
+			Type:           RuleAlertingType,
+		})
+	}
+	return nil, errors.New("rule: MarshalJSON called on a Rule with no recording or alert result set")
+}
+
+func parseAlertState(s string) (AlertState, error) {
+	switch strings.ToUpper(s) {
+	case "INACTIVE":
+		return AlertState_INACTIVE, nil
+	case "PENDING":
+		return AlertState_PENDING, nil
+	case "FIRING":
+		return AlertState_FIRING, nil
+	default:
+		return AlertState_INACTIVE, errors.Errorf("unknown alertState: %q", s)
+	}
+}
+
+func parsePartialResponseStrategy(s string) (PartialResponseStrategy, error) {
+	if s == "" {
+		return PartialResponseStrategy_WARN, nil
+	}
+	strategy, ok := PartialResponseStrategy_value[strings.ToUpper(s)]
+	if !ok {
+		return 0, errors.Errorf("unknown partialResponseStrategy: %q", s)
+	}
+	return PartialResponseStrategy(strategy), nil
+}
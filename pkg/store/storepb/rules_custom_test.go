@@ -314,7 +314,7 @@ func TestJSONUnmarshalMarshal(t *testing.T) {
 									Recording: &RecordingRule{
 										Query: "up",
 										Name:  "recording1",
-										Labels: &PromLabels{
+										Labels: PromLabels{
 											Labels: []Label{
 												{Name: "a", Value: "b"},
 												{Name: "c", Value: "d"},
@@ -332,13 +332,13 @@ func TestJSONUnmarshalMarshal(t *testing.T) {
 									Alert: &Alert{
 										Name:  "alert1",
 										Query: "up == 0",
-										Labels: &PromLabels{
+										Labels: PromLabels{
 											Labels: []Label{
 												{Name: "a2", Value: "b2"},
 												{Name: "c2", Value: "d2"},
 											},
 										},
-										Annotations: &PromLabels{
+										Annotations: PromLabels{
 											Labels: []Label{
 												{Name: "ann1", Value: "ann44"},
 												{Name: "ann2", Value: "ann33"},
@@ -346,12 +346,12 @@ func TestJSONUnmarshalMarshal(t *testing.T) {
 										},
 										Alerts: []*AlertInstance{
 											{
-												Labels: &PromLabels{
+												Labels: PromLabels{
 													Labels: []Label{
 														{Name: "instance1", Value: "1"},
 													},
 												},
-												Annotations: &PromLabels{
+												Annotations: PromLabels{
 													Labels: []Label{
 														{Name: "annotation1", Value: "2"},
 													},
@@ -362,8 +362,8 @@ func TestJSONUnmarshalMarshal(t *testing.T) {
 												PartialResponseStrategy: PartialResponseStrategy_WARN,
 											},
 											{
-												Labels:                  &PromLabels{},
-												Annotations:             &PromLabels{},
+												Labels:                  PromLabels{},
+												Annotations:             PromLabels{},
 												State:                   AlertState_FIRING,
 												ActiveAt:                &twoHoursAgo,
 												Value:                   "2143",
@@ -399,6 +399,66 @@ func TestJSONUnmarshalMarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Regression test for an alerting rule that is currently firing
+			// but has no active alerts attached (e.g. right after they all
+			// resolved): EvaluationTime/LastEvaluation/State on the rule
+			// itself must still round-trip exactly.
+			name: "one group with a firing rule with no active alerts",
+			input: &prometheusRuleDiscovery{
+				RuleGroups: []*prometheusRuleGroup{
+					{
+						Name: "group1",
+						Rules: []prometheusRule{
+							prometheusAlertingRule{
+								Type:           RuleAlertingType,
+								Name:           "alert1",
+								Query:          "up == 0",
+								State:          "FIRING",
+								Health:         "ok",
+								LastEvaluation: now,
+								EvaluationTime: 0.2,
+							},
+						},
+						File:                              "file1.yml",
+						Interval:                          10,
+						LastEvaluation:                    now,
+						EvaluationTime:                     0.3,
+						DeprecatedPartialResponseStrategy: "WARN",
+						PartialResponseStrategy:           "WARN",
+					},
+				},
+			},
+			expectedProto: &RuleGroups{
+				Groups: []*RuleGroup{
+					{
+						Name: "group1",
+						Rules: []*Rule{
+							{
+								Result: &Rule_Alert{
+									Alert: &Alert{
+										Name:                      "alert1",
+										Query:                     "up == 0",
+										Labels:                    PromLabels{},
+										Annotations:               PromLabels{},
+										State:                     AlertState_FIRING,
+										Health:                    "ok",
+										LastEvaluation:            now,
+										EvaluationDurationSeconds: 0.2,
+									},
+								},
+							},
+						},
+						File:                              "file1.yml",
+						Interval:                          10,
+						LastEvaluation:                    now,
+						EvaluationDurationSeconds:         0.3,
+						DeprecatedPartialResponseStrategy: PartialResponseStrategy_WARN,
+						PartialResponseStrategy:           PartialResponseStrategy_WARN,
+					},
+				},
+			},
+		},
 	} {
 		if ok := t.Run(tcase.name, func(t *testing.T) {
 			jsonInput, err := json.Marshal(tcase.input)
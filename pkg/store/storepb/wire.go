@@ -0,0 +1,266 @@
+// Copyright (c) The Thanos Authors.
+// Licensed under the Apache License 2.0.
+
+package storepb
+
+// Shared low-level protobuf wire-format helpers used by the Marshal/Size/
+// Unmarshal methods in this package's *.pb.go files. A real protoc-gen-gogo
+// run duplicates equivalents of these into every generated file; they are
+// centralized here because those files are hand-maintained rather than
+// produced by protoc in this tree.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+var errWireOverflow = fmt.Errorf("proto: integer overflow")
+
+func sizeVarint(v uint64) (n int) {
+	for {
+		n++
+		v >>= 7
+		if v == 0 {
+			return n
+		}
+	}
+}
+
+func appendVarint(dAtA []byte, v uint64) []byte {
+	for v >= 0x80 {
+		dAtA = append(dAtA, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(dAtA, byte(v))
+}
+
+func appendTag(dAtA []byte, fieldNum, wireType int) []byte {
+	return appendVarint(dAtA, uint64(fieldNum)<<3|uint64(wireType))
+}
+
+func appendStringField(dAtA []byte, fieldNum int, s string) []byte {
+	if len(s) == 0 {
+		return dAtA
+	}
+	dAtA = appendTag(dAtA, fieldNum, 2)
+	dAtA = appendVarint(dAtA, uint64(len(s)))
+	return append(dAtA, s...)
+}
+
+func sizeStringField(fieldNum int, s string) int {
+	if len(s) == 0 {
+		return 0
+	}
+	return sizeVarint(uint64(fieldNum)<<3|2) + sizeVarint(uint64(len(s))) + len(s)
+}
+
+func appendVarintField(dAtA []byte, fieldNum int, v uint64) []byte {
+	if v == 0 {
+		return dAtA
+	}
+	dAtA = appendTag(dAtA, fieldNum, 0)
+	return appendVarint(dAtA, v)
+}
+
+func sizeVarintField(fieldNum int, v uint64) int {
+	if v == 0 {
+		return 0
+	}
+	return sizeVarint(uint64(fieldNum)<<3) + sizeVarint(v)
+}
+
+func appendFixed64Field(dAtA []byte, fieldNum int, v float64) []byte {
+	if v == 0 {
+		return dAtA
+	}
+	dAtA = appendTag(dAtA, fieldNum, 1)
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	return append(dAtA, buf[:]...)
+}
+
+func sizeFixed64Field(fieldNum int, v float64) int {
+	if v == 0 {
+		return 0
+	}
+	return sizeVarint(uint64(fieldNum)<<3|1) + 8
+}
+
+// appendMessageField appends a length-delimited submessage produced by
+// marshalTo. It is skipped entirely when the submessage is empty, matching
+// proto3's "absent means zero value" semantics for message-typed fields.
+func appendMessageField(dAtA []byte, fieldNum int, sub []byte) []byte {
+	if len(sub) == 0 {
+		return dAtA
+	}
+	dAtA = appendTag(dAtA, fieldNum, 2)
+	dAtA = appendVarint(dAtA, uint64(len(sub)))
+	return append(dAtA, sub...)
+}
+
+func sizeMessageField(fieldNum, size int) int {
+	if size == 0 {
+		return 0
+	}
+	return sizeVarint(uint64(fieldNum)<<3|2) + sizeVarint(uint64(size)) + size
+}
+
+// appendMessageFieldAlways is like appendMessageField but always emits the
+// field, even when the submessage is empty. Used for non-nullable
+// (gogoproto.nullable = false) message-typed fields, which have no "unset"
+// representation to omit.
+func appendMessageFieldAlways(dAtA []byte, fieldNum int, sub []byte) []byte {
+	dAtA = appendTag(dAtA, fieldNum, 2)
+	dAtA = appendVarint(dAtA, uint64(len(sub)))
+	return append(dAtA, sub...)
+}
+
+func sizeMessageFieldAlways(fieldNum, size int) int {
+	return sizeVarint(uint64(fieldNum)<<3|2) + sizeVarint(uint64(size)) + size
+}
+
+func readTag(dAtA []byte, i int) (fieldNum, wireType, n int, err error) {
+	var v uint64
+	start := i
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, 0, errWireOverflow
+		}
+		if i >= len(dAtA) {
+			return 0, 0, 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[i]
+		i++
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			break
+		}
+	}
+	return int(v >> 3), int(v & 0x7), i - start, nil
+}
+
+func readVarint(dAtA []byte, i int) (uint64, int, error) {
+	var v uint64
+	start := i
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, errWireOverflow
+		}
+		if i >= len(dAtA) {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[i]
+		i++
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			break
+		}
+	}
+	return v, i - start, nil
+}
+
+func readFixed64(dAtA []byte, i int) (float64, error) {
+	if i+8 > len(dAtA) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(dAtA[i : i+8])), nil
+}
+
+func readLengthDelimited(dAtA []byte, i int) (b []byte, end int, err error) {
+	l, n, err := readVarint(dAtA, i)
+	if err != nil {
+		return nil, 0, err
+	}
+	i += n
+	end = i + int(l)
+	if end < i || end > len(dAtA) {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	return dAtA[i:end], end, nil
+}
+
+func skipField(dAtA []byte, i, wireType int) (int, error) {
+	switch wireType {
+	case 0:
+		_, n, err := readVarint(dAtA, i)
+		if err != nil {
+			return 0, err
+		}
+		return i + n, nil
+	case 1:
+		if i+8 > len(dAtA) {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return i + 8, nil
+	case 2:
+		_, end, err := readLengthDelimited(dAtA, i)
+		if err != nil {
+			return 0, err
+		}
+		return end, nil
+	case 5:
+		if i+4 > len(dAtA) {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return i + 4, nil
+	default:
+		return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+	}
+}
+
+// marshalStdTime encodes t as a google.protobuf.Timestamp submessage, the
+// wire representation gogoproto's (gogoproto.stdtime) option maps time.Time
+// to.
+func marshalStdTime(t time.Time) []byte {
+	var dAtA []byte
+	if sec := t.Unix(); sec != 0 {
+		dAtA = appendVarintField(dAtA, 1, uint64(sec))
+	}
+	if nsec := t.Nanosecond(); nsec != 0 {
+		dAtA = appendVarintField(dAtA, 2, uint64(nsec))
+	}
+	return dAtA
+}
+
+func unmarshalStdTime(dAtA []byte) (time.Time, error) {
+	var sec int64
+	var nsec int32
+	i := 0
+	for i < len(dAtA) {
+		fieldNum, wireType, n, err := readTag(dAtA, i)
+		if err != nil {
+			return time.Time{}, err
+		}
+		i += n
+		switch fieldNum {
+		case 1:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return time.Time{}, err
+			}
+			i += n
+			sec = int64(v)
+		case 2:
+			v, n, err := readVarint(dAtA, i)
+			if err != nil {
+				return time.Time{}, err
+			}
+			i += n
+			nsec = int32(v)
+		default:
+			ni, err := skipField(dAtA, i, wireType)
+			if err != nil {
+				return time.Time{}, err
+			}
+			i = ni
+		}
+	}
+	return time.Unix(sec, int64(nsec)).UTC(), nil
+}
+
+func timeEqual(a, b time.Time) bool {
+	return a.Equal(b)
+}